@@ -13,6 +13,11 @@
 //     even when count == 0.
 package btmp
 
+import (
+	"io"
+	"iter"
+)
+
 const (
 	WordBits         = 64
 	WordShift        = 6            // log2(64), divide by 64 via >> 6
@@ -42,6 +47,58 @@ func New(n uint) *Bitmap {
 	return b
 }
 
+// NewFromWords returns a bitmap of n bits that takes ownership of words
+// (no copy). It is the inverse of Words(). Bits at indexes >= n are cleared
+// in place to preserve the zero-beyond-Len invariant, so callers must not
+// rely on words afterward. Panics if len(words) < ceil(n/64).
+func NewFromWords(words []uint64, n uint) *Bitmap {
+	if err := validateWordsLength(words, n); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.NewFromWords"))
+	}
+
+	b := &Bitmap{
+		words:   words,
+		lenBits: int(n),
+	}
+	b.computeCache()
+	b.clearBeyondLen()
+	return b
+}
+
+// FromBytes returns a bitmap of n bits decoded from data in the ToBytes
+// layout: bit i is read from byte i/8 at bit position i%8 (LSB-first).
+// The data is copied. Extra bytes and bits beyond n are ignored.
+// Panics if len(data) < ceil(n/8).
+func FromBytes(data []byte, n uint) *Bitmap {
+	if err := validateBytesLength(data, n); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.FromBytes"))
+	}
+	return fromBytes(data, int(n))
+}
+
+// Clone returns a deep copy of b with the same Len() and independent storage.
+// The clone's words slice is sized to exactly the logical word count.
+func (b *Bitmap) Clone() *Bitmap {
+	c := &Bitmap{
+		words:   make([]uint64, b.lastWordIdx+1),
+		lenBits: b.lenBits,
+	}
+	copy(c.words, b.words)
+	c.computeCache()
+	return c
+}
+
+// Slice returns a new, independent bitmap of Len() == count holding a copy of
+// bits [start, start+count): bit 0 of the result is bit start of b.
+// Panics if start < 0, count < 0, or start+count > Len().
+func (b *Bitmap) Slice(start, count int) *Bitmap {
+	if err := b.validateRange(start, count); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Slice"))
+	}
+
+	return b.slice(start, count)
+}
+
 // ========================================
 // Accessors
 // ========================================
@@ -52,6 +109,10 @@ func (b *Bitmap) Len() int { return b.lenBits }
 // Words exposes the underlying words slice (length may exceed the logical need).
 func (b *Bitmap) Words() []uint64 { return b.words }
 
+// Cap returns the backing capacity in bits (cap(Words())*64).
+// Growth up to Cap() via EnsureBits or AddBits does not allocate.
+func (b *Bitmap) Cap() int { return cap(b.words) * WordBits }
+
 // ========================================
 // Growth Operations
 // ========================================
@@ -84,6 +145,92 @@ func (b *Bitmap) AddBits(n int) *Bitmap {
 	return b
 }
 
+// Concat appends other's bits to b, growing Len() by other.Len().
+// other is left unchanged; no-op if other is empty.
+// Returns *Bitmap for chaining. Panics if other is nil.
+func (b *Bitmap) Concat(other *Bitmap) *Bitmap {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Concat"))
+	}
+
+	b.concat(other)
+	b.computeCache()
+	return b
+}
+
+// Reserve grows the backing capacity to hold at least n bits so subsequent
+// growth up to n is allocation-free. Len() and all bits are unchanged.
+// Returns *Bitmap for chaining. Panics if n < 0.
+func (b *Bitmap) Reserve(n int) *Bitmap {
+	if err := validateNonNegative(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Reserve"))
+	}
+
+	b.reserve(n)
+	return b
+}
+
+// Truncate shrinks the logical length to n bits. No-op if n >= Len().
+// Bits at indexes >= n are cleared; the backing storage is retained for reuse.
+// Returns *Bitmap for chaining. Panics if n < 0.
+func (b *Bitmap) Truncate(n int) *Bitmap {
+	if err := validateNonNegative(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Truncate"))
+	}
+
+	if n < b.lenBits {
+		b.truncate(n)
+		b.computeCache()
+	}
+	return b
+}
+
+// Resize sets the logical length to exactly n bits, growing like EnsureBits
+// (new bits are zero) or shrinking like Truncate (discarded bits are cleared).
+// Returns *Bitmap for chaining. Panics if n < 0.
+func (b *Bitmap) Resize(n int) *Bitmap {
+	if err := validateNonNegative(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Resize"))
+	}
+
+	if n != b.lenBits {
+		b.resize(n)
+		b.computeCache()
+	}
+	return b
+}
+
+// CopyFrom overwrites b to be bit-for-bit equal to other, growing or shrinking
+// Len() to other.Len() and reusing b's backing storage where possible.
+// other is not modified. Returns *Bitmap for chaining. Panics if other is nil.
+func (b *Bitmap) CopyFrom(other *Bitmap) *Bitmap {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.CopyFrom"))
+	}
+
+	b.copyFrom(other)
+	b.computeCache()
+	return b
+}
+
+// ShrinkToFit releases unused backing memory by reallocating storage to
+// exactly ceil(Len()/64) words. Len() and all bits are preserved.
+// No-op if storage is already exact. Returns *Bitmap for chaining.
+func (b *Bitmap) ShrinkToFit() *Bitmap {
+	b.shrinkToFit()
+	b.computeCache()
+	return b
+}
+
+// Reset clears all bits and sets Len() to 0 while keeping the backing
+// capacity, so a later EnsureBits can reuse it without allocating.
+// The result behaves exactly like New(0). Returns *Bitmap for chaining.
+func (b *Bitmap) Reset() *Bitmap {
+	b.reset()
+	b.computeCache()
+	return b
+}
+
 // ========================================
 // Query Operations
 // ========================================
@@ -116,6 +263,15 @@ func (b *Bitmap) Count() int {
 	return b.count()
 }
 
+// Density returns the fraction of set bits in [0, Len()), Count()/Len(),
+// in the range [0.0, 1.0]. Returns 0 for an empty bitmap.
+func (b *Bitmap) Density() float64 {
+	if b.lenBits == 0 {
+		return 0
+	}
+	return float64(b.count()) / float64(b.lenBits)
+}
+
 // AnyRange reports whether any bit in [start, start+count) is set.
 // Returns false for empty ranges (count == 0).
 // Panics if start < 0, count < 0, or start+count > Len().
@@ -177,6 +333,80 @@ func (b *Bitmap) NextOne(pos int) int {
 	return b.nextOne(pos)
 }
 
+// PrevZero returns the position of the nearest zero bit at or before pos.
+// Returns -1 if no zero bit exists in [0, pos].
+// Panics if pos < 0 or pos >= Len().
+func (b *Bitmap) PrevZero(pos int) int {
+	if err := validateNonNegative(pos, "pos"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrevZero"))
+	}
+	if err := b.validateInBounds(pos); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrevZero"))
+	}
+
+	return b.prevZero(pos)
+}
+
+// PrevOne returns the position of the nearest set bit at or before pos.
+// Returns -1 if no set bit exists in [0, pos].
+// Panics if pos < 0 or pos >= Len().
+func (b *Bitmap) PrevOne(pos int) int {
+	if err := validateNonNegative(pos, "pos"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrevOne"))
+	}
+	if err := b.validateInBounds(pos); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrevOne"))
+	}
+
+	return b.prevOne(pos)
+}
+
+// FirstSet returns the lowest set bit position.
+// Returns -1 if no bit is set or Len() == 0.
+func (b *Bitmap) FirstSet() int {
+	return b.firstSet()
+}
+
+// LastSet returns the highest set bit position.
+// Returns -1 if no bit is set or Len() == 0.
+func (b *Bitmap) LastSet() int {
+	return b.lastSet()
+}
+
+// FindFirstRun returns the lowest start such that [start, start+count) are
+// all zero and start+count <= Len(). Returns -1 if no such run exists.
+// Panics if count <= 0.
+func (b *Bitmap) FindFirstRun(count int) int {
+	if err := validatePositive(count, "count"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.FindFirstRun"))
+	}
+
+	return b.findRun(0, count, false)
+}
+
+// NextRun returns the first index >= start where count consecutive bits equal
+// value, or -1 if no such run fits before Len().
+// Panics if start < 0, start >= Len(), or count <= 0.
+func (b *Bitmap) NextRun(start, count int, value bool) int {
+	if err := validateNonNegative(start, "start"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.NextRun"))
+	}
+	if err := b.validateInBounds(start); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.NextRun"))
+	}
+	if err := validatePositive(count, "count"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.NextRun"))
+	}
+
+	return b.findRun(start, count, value)
+}
+
+// CountRuns returns the number of maximal runs of consecutive bits equal to
+// value in [0, Len()). Returns 0 for empty bitmaps.
+func (b *Bitmap) CountRuns(value bool) int {
+	return b.countRuns(value)
+}
+
 // NextZeroInRange returns the position of the next zero bit in [pos, pos+count).
 // Returns -1 if no zero bit exists in range.
 // Panics if pos < 0, count <= 0, or pos+count > Len().
@@ -253,6 +483,64 @@ func (b *Bitmap) CountOnesFromInRange(pos, count int) int {
 	return b.countOnesFromInRange(pos, count)
 }
 
+// Rank returns the number of set bits in [0, pos).
+// Rank(Len()) == Count(). Panics if pos < 0 or pos > Len().
+func (b *Bitmap) Rank(pos int) int {
+	if err := validateNonNegative(pos, "pos"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Rank"))
+	}
+	if err := b.validateInBoundsInclusive(pos); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Rank"))
+	}
+
+	return b.rank(pos)
+}
+
+// Select returns the position of the k-th set bit (0-based), the inverse of
+// Rank: Rank(Select(k)) == k. Returns -1 if k >= Count().
+// Panics if k < 0.
+func (b *Bitmap) Select(k int) int {
+	if err := validateNonNegative(k, "k"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Select"))
+	}
+
+	return b.selectBit(k)
+}
+
+// Positions returns the indexes of all set bits in [0, Len()) in ascending order.
+// Returns an empty, non-nil slice if no bits are set.
+func (b *Bitmap) Positions() []int {
+	return b.positions()
+}
+
+// ========================================
+// Iteration Operations
+// ========================================
+
+// OneBits returns an iterator over the positions of set bits in [0, Len()),
+// in ascending order. Stops early if the consumer breaks.
+//
+//	for i := range b.OneBits() { ... }
+func (b *Bitmap) OneBits() iter.Seq[int] {
+	return b.bitsSeq(true)
+}
+
+// ZeroBits returns an iterator over the positions of clear bits in [0, Len()),
+// in ascending order. Never yields positions >= Len(). Stops early if the
+// consumer breaks.
+func (b *Bitmap) ZeroBits() iter.Seq[int] {
+	return b.bitsSeq(false)
+}
+
+// Runs returns an iterator over maximal runs of consecutive set bits in
+// [0, Len()), yielding (start, length) in ascending order. Yields nothing if
+// no bit is set. Stops early if the consumer breaks.
+//
+//	for start, n := range b.Runs() { ... }
+func (b *Bitmap) Runs() iter.Seq2[int, int] {
+	return b.runs()
+}
+
 // ========================================
 // Validation Operations
 // ========================================
@@ -313,10 +601,68 @@ func (b *Bitmap) FlipBit(pos int) *Bitmap {
 	return b
 }
 
+// TrySetBit sets bit pos to 1 like SetBit, but returns the ValidationError
+// instead of panicking. b is unchanged on error.
+func (b *Bitmap) TrySetBit(pos int) error {
+	if err := validateNonNegative(pos, "pos"); err != nil {
+		return err.(*ValidationError).WithContext("Bitmap.TrySetBit")
+	}
+	if err := b.validateInBounds(pos); err != nil {
+		return err.(*ValidationError).WithContext("Bitmap.TrySetBit")
+	}
+
+	b.setBit(pos)
+	return nil
+}
+
+// TryClearBit sets bit pos to 0 like ClearBit, but returns the
+// ValidationError instead of panicking. b is unchanged on error.
+func (b *Bitmap) TryClearBit(pos int) error {
+	if err := validateNonNegative(pos, "pos"); err != nil {
+		return err.(*ValidationError).WithContext("Bitmap.TryClearBit")
+	}
+	if err := b.validateInBounds(pos); err != nil {
+		return err.(*ValidationError).WithContext("Bitmap.TryClearBit")
+	}
+
+	b.clearBit(pos)
+	return nil
+}
+
+// SetPositions sets every listed bit to 1. All positions are validated before
+// any bit is modified. No-op if positions is empty.
+// Returns *Bitmap for chaining. Panics if any position is < 0 or >= Len(),
+// naming the first invalid index.
+func (b *Bitmap) SetPositions(positions ...int) *Bitmap {
+	if err := b.validatePositions(positions); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.SetPositions"))
+	}
+
+	b.setPositions(positions)
+	return b
+}
+
 // ========================================
 // Multi-Bit Mutators
 // ========================================
 
+// GetBits returns n bits starting at pos, right-aligned: bit pos becomes
+// bit 0 of the result. The read counterpart of SetBits.
+// Panics if pos < 0, n <= 0, n > 64, or pos+n > Len().
+func (b *Bitmap) GetBits(pos, n int) uint64 {
+	if err := validateNonNegative(pos, "pos"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.GetBits"))
+	}
+	if err := validateWordBits(n); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.GetBits"))
+	}
+	if err := b.validateRange(pos, n); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.GetBits"))
+	}
+
+	return b.getBits(pos, n)
+}
+
 // SetBits inserts the low n bits of val into the bitmap starting at pos.
 // Only the least significant n bits of val are used; higher bits are ignored.
 // Preserves surrounding bits unchanged. Panics if pos < 0, n <= 0, n > 64, or pos+n > Len().
@@ -341,6 +687,20 @@ func (b *Bitmap) SetBits(pos, n int, val uint64) *Bitmap {
 	return b
 }
 
+// AppendBits grows Len() by n and writes the low n bits of val at the old end,
+// equivalent to AddBits(n) followed by SetBits(oldLen, n, val).
+// Preserves existing bits. Panics if n <= 0 or n > 64.
+// Returns *Bitmap for chaining.
+func (b *Bitmap) AppendBits(n int, val uint64) *Bitmap {
+	if err := validateWordBits(n); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.AppendBits"))
+	}
+
+	b.appendBits(n, val)
+	b.computeCache()
+	return b
+}
+
 // ========================================
 // Range Mutators
 // ========================================
@@ -356,6 +716,17 @@ func (b *Bitmap) SetRange(start, count int) *Bitmap {
 	return b
 }
 
+// TrySetRange sets bits in [start, start+count) to 1 like SetRange, but
+// returns the ValidationError instead of panicking. b is unchanged on error.
+func (b *Bitmap) TrySetRange(start, count int) error {
+	if err := b.validateRange(start, count); err != nil {
+		return err.(*ValidationError).WithContext("Bitmap.TrySetRange")
+	}
+
+	b.setRange(start, count)
+	return nil
+}
+
 // ClearRange clears bits in [start, start+count) to 0. In-bounds only.
 // Returns *Bitmap for chaining. Panics on negative inputs, overflow, or out-of-bounds.
 func (b *Bitmap) ClearRange(start, count int) *Bitmap {
@@ -367,6 +738,45 @@ func (b *Bitmap) ClearRange(start, count int) *Bitmap {
 	return b
 }
 
+// SetRangeValue sets bits in [start, start+count) to v, dispatching to
+// SetRange when v is true and ClearRange otherwise. In-bounds only.
+// Returns *Bitmap for chaining. Panics on negative inputs, overflow, or out-of-bounds.
+func (b *Bitmap) SetRangeValue(start, count int, v bool) *Bitmap {
+	if err := b.validateRange(start, count); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.SetRangeValue"))
+	}
+
+	if v {
+		b.setRange(start, count)
+	} else {
+		b.clearRange(start, count)
+	}
+	return b
+}
+
+// FlipRange toggles bits in [start, start+count). In-bounds only.
+// Returns *Bitmap for chaining. Panics on negative inputs, overflow, or out-of-bounds.
+func (b *Bitmap) FlipRange(start, count int) *Bitmap {
+	if err := b.validateRange(start, count); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.FlipRange"))
+	}
+
+	b.flipRange(start, count)
+	return b
+}
+
+// ReverseRange mirrors bits in [start, start+count) so bit start+i moves to
+// start+count-1-i. In-bounds only.
+// Returns *Bitmap for chaining. Panics on negative inputs, overflow, or out-of-bounds.
+func (b *Bitmap) ReverseRange(start, count int) *Bitmap {
+	if err := b.validateRange(start, count); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.ReverseRange"))
+	}
+
+	b.reverseRange(start, count)
+	return b
+}
+
 // CopyRange copies count bits from src[srcStart:] to dst[dstStart:].
 // In-bounds only for both src and dst. Overlap-safe with memmove semantics.
 // Returns *Bitmap for chaining. Panics on negative inputs, nil src, or out-of-bounds.
@@ -421,6 +831,67 @@ func (b *Bitmap) ClearAll() *Bitmap {
 	return b
 }
 
+// Reverse mirrors all bits so bit i moves to Len()-1-i. Count() is preserved.
+// Returns *Bitmap for chaining.
+func (b *Bitmap) Reverse() *Bitmap {
+	b.reverse()
+	return b
+}
+
+// ShiftLeft shifts bits toward higher indexes: bit i moves to i+n.
+// Bits shifted to indexes >= Len() are dropped and vacated positions become
+// zero. Len() is unchanged; shifting by n >= Len() clears all bits.
+// Returns *Bitmap for chaining. Panics if n < 0.
+func (b *Bitmap) ShiftLeft(n int) *Bitmap {
+	if err := validateNonNegative(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.ShiftLeft"))
+	}
+
+	b.shiftUp(n)
+	return b
+}
+
+// ShiftRight shifts bits toward lower indexes: bit i moves to i-n.
+// Bits shifted below index 0 are dropped and vacated positions become
+// zero. Len() is unchanged; shifting by n >= Len() clears all bits.
+// Returns *Bitmap for chaining. Panics if n < 0.
+func (b *Bitmap) ShiftRight(n int) *Bitmap {
+	if err := validateNonNegative(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.ShiftRight"))
+	}
+
+	b.shiftDown(n)
+	return b
+}
+
+// RotateLeft rotates bits toward higher indexes: bit i moves to (i+n) mod Len().
+// Bits leaving the top wrap around to the bottom; Count() is preserved.
+// No-op for empty bitmaps or when n is a multiple of Len().
+// Returns *Bitmap for chaining. Panics if n < 0.
+func (b *Bitmap) RotateLeft(n int) *Bitmap {
+	if err := validateNonNegative(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.RotateLeft"))
+	}
+
+	b.rotateUp(n)
+	return b
+}
+
+// RotateRight rotates bits toward lower indexes: bit i moves to (i-n) mod Len().
+// Bits leaving the bottom wrap around to the top; Count() is preserved.
+// No-op for empty bitmaps or when n is a multiple of Len().
+// Returns *Bitmap for chaining. Panics if n < 0.
+func (b *Bitmap) RotateRight(n int) *Bitmap {
+	if err := validateNonNegative(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.RotateRight"))
+	}
+
+	if b.lenBits > 0 {
+		b.rotateUp(b.lenBits - n%b.lenBits)
+	}
+	return b
+}
+
 // ========================================
 // Logical Operations
 // ========================================
@@ -467,6 +938,47 @@ func (b *Bitmap) Xor(other *Bitmap) *Bitmap {
 	return b
 }
 
+// OrAt ORs other's bits [0, other.Len()) onto b's bits [offset, offset+other.Len()).
+// Bits of b outside that window are untouched.
+// Returns *Bitmap for chaining.
+// Panics if other is nil, offset < 0, or offset+other.Len() > Len().
+func (b *Bitmap) OrAt(offset int, other *Bitmap) *Bitmap {
+	if err := b.validateAt(offset, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.OrAt"))
+	}
+
+	b.orAt(offset, other)
+	return b
+}
+
+// XorAt XORs other's bits [0, other.Len()) onto b's bits [offset, offset+other.Len()).
+// Bits of b outside that window are untouched.
+// Returns *Bitmap for chaining.
+// Panics if other is nil, offset < 0, or offset+other.Len() > Len().
+func (b *Bitmap) XorAt(offset int, other *Bitmap) *Bitmap {
+	if err := b.validateAt(offset, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.XorAt"))
+	}
+
+	b.xorAt(offset, other)
+	return b
+}
+
+// AndNot clears every bit in b that is set in other (b &^= other).
+// Both bitmaps must have the same length.
+// Returns *Bitmap for chaining. Panics if other is nil or lengths differ.
+func (b *Bitmap) AndNot(other *Bitmap) *Bitmap {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.AndNot"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.AndNot"))
+	}
+
+	b.andNot(other)
+	return b
+}
+
 // Not performs bitwise NOT, flipping all bits in [0, Len()).
 // Returns *Bitmap for chaining.
 func (b *Bitmap) Not() *Bitmap {
@@ -474,6 +986,254 @@ func (b *Bitmap) Not() *Bitmap {
 	return b
 }
 
+// AndNew returns a new bitmap holding the bitwise AND of b and other.
+// Both bitmaps must have the same length; neither operand is modified.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) AndNew(other *Bitmap) *Bitmap {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.AndNew"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.AndNew"))
+	}
+
+	c := b.Clone()
+	c.and(other)
+	return c
+}
+
+// OrNew returns a new bitmap holding the bitwise OR of b and other.
+// Both bitmaps must have the same length; neither operand is modified.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) OrNew(other *Bitmap) *Bitmap {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.OrNew"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.OrNew"))
+	}
+
+	c := b.Clone()
+	c.or(other)
+	return c
+}
+
+// XorNew returns a new bitmap holding the bitwise XOR of b and other.
+// Both bitmaps must have the same length; neither operand is modified.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) XorNew(other *Bitmap) *Bitmap {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.XorNew"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.XorNew"))
+	}
+
+	c := b.Clone()
+	c.xor(other)
+	return c
+}
+
+// ========================================
+// Comparison Operations
+// ========================================
+
+// Equal reports whether b and other have the same Len() and identical bits.
+// Neither bitmap is modified; capacity is ignored. Panics if other is nil.
+func (b *Bitmap) Equal(other *Bitmap) bool {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Equal"))
+	}
+
+	return b.equal(other)
+}
+
+// Hash returns a deterministic 64-bit FNV-1a fingerprint of Len() and the
+// logical bits. Equal bitmaps always hash equally; capacity is ignored.
+// Not suitable for cryptographic use.
+func (b *Bitmap) Hash() uint64 {
+	return b.hash()
+}
+
+// Intersects reports whether b and other share any set bit.
+// Neither bitmap is modified; stops at the first overlapping word.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) Intersects(other *Bitmap) bool {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Intersects"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Intersects"))
+	}
+
+	return b.intersects(other)
+}
+
+// IsSubsetOf reports whether every set bit of b is also set in other
+// (b &^ other == 0). Vacuously true for empty bitmaps.
+// Neither bitmap is modified; stops at the first violating word.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) IsSubsetOf(other *Bitmap) bool {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.IsSubsetOf"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.IsSubsetOf"))
+	}
+
+	return b.isSubsetOf(other)
+}
+
+// IsDisjoint reports whether b and other share no set bit (b & other == 0).
+// Neither bitmap is modified; stops at the first overlapping word.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) IsDisjoint(other *Bitmap) bool {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.IsDisjoint"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.IsDisjoint"))
+	}
+
+	return !b.intersects(other)
+}
+
+// HammingDistance returns the number of positions at which b and other differ,
+// popcount(b XOR other). Returns 0 for two empty bitmaps.
+// Neither bitmap is modified. Panics if other is nil or lengths differ.
+func (b *Bitmap) HammingDistance(other *Bitmap) int {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.HammingDistance"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.HammingDistance"))
+	}
+
+	return b.xorCount(other)
+}
+
+// AndCount returns popcount(b AND other) without allocating or modifying
+// either bitmap. Returns 0 for two empty bitmaps.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) AndCount(other *Bitmap) int {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.AndCount"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.AndCount"))
+	}
+
+	return b.andCount(other)
+}
+
+// OrCount returns popcount(b OR other) without allocating or modifying
+// either bitmap. Returns 0 for two empty bitmaps.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) OrCount(other *Bitmap) int {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.OrCount"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.OrCount"))
+	}
+
+	return b.orCount(other)
+}
+
+// XorCount returns popcount(b XOR other) without allocating or modifying
+// either bitmap. Equivalent to HammingDistance. Returns 0 for two empty bitmaps.
+// Panics if other is nil or lengths differ.
+func (b *Bitmap) XorCount(other *Bitmap) int {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.XorCount"))
+	}
+	if err := validateSameLength(b, other); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.XorCount"))
+	}
+
+	return b.xorCount(other)
+}
+
+// ========================================
+// Encoding Operations
+// ========================================
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The encoding is an 8-byte little-endian Len() header followed by
+// ceil(Len()/64) little-endian words.
+func (b *Bitmap) MarshalBinary() ([]byte, error) {
+	return b.marshalBinary(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// Replaces the contents of b with the decoded bitmap.
+// Returns ValidationError on truncated or inconsistent input.
+func (b *Bitmap) UnmarshalBinary(data []byte) error {
+	if err := b.unmarshalBinary(data); err != nil {
+		return err.(*ValidationError).WithContext("Bitmap.UnmarshalBinary")
+	}
+	return nil
+}
+
+// WriteTo implements io.WriterTo, streaming the MarshalBinary format to w
+// without materializing the full encoding.
+// Returns the number of bytes written and any error from w.
+func (b *Bitmap) WriteTo(w io.Writer) (int64, error) {
+	return b.writeTo(w)
+}
+
+// ReadFrom implements io.ReaderFrom, reading one bitmap in the MarshalBinary
+// format from r and replacing the contents of b. b is left unchanged on error.
+// Returns io.EOF if r is empty, io.ErrUnexpectedEOF on partial input, and
+// ValidationError on inconsistent input.
+func (b *Bitmap) ReadFrom(r io.Reader) (int64, error) {
+	n, err := b.readFrom(r)
+	if ve, ok := err.(*ValidationError); ok {
+		return n, ve.WithContext("Bitmap.ReadFrom")
+	}
+	return n, err
+}
+
+// MarshalJSON implements json.Marshaler.
+// The encoding is {"len":N,"words":["0x...",...]} with ceil(N/64) hex words.
+func (b *Bitmap) MarshalJSON() ([]byte, error) {
+	return b.marshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Replaces the contents of b with the decoded bitmap. Returns ValidationError
+// if len is negative, the word count does not match len, or a word is not a
+// valid hex string.
+func (b *Bitmap) UnmarshalJSON(data []byte) error {
+	err := b.unmarshalJSON(data)
+	if ve, ok := err.(*ValidationError); ok {
+		return ve.WithContext("Bitmap.UnmarshalJSON")
+	}
+	return err
+}
+
+// ToBytes returns the bits in [0, Len()) as ceil(Len()/8) bytes, LSB-first:
+// bit i is stored in byte i/8 at bit position i%8. Bits beyond Len() in the
+// final byte are zero. This layout is stable and intended for cross-language
+// interop; it does not include the length.
+func (b *Bitmap) ToBytes() []byte {
+	return b.toBytes()
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary format.
+func (b *Bitmap) GobEncode() ([]byte, error) {
+	return b.marshalBinary(), nil
+}
+
+// GobDecode implements gob.GobDecoder using the MarshalBinary format.
+// Returns ValidationError on truncated or inconsistent input.
+func (b *Bitmap) GobDecode(data []byte) error {
+	if err := b.unmarshalBinary(data); err != nil {
+		return err.(*ValidationError).WithContext("Bitmap.GobDecode")
+	}
+	return nil
+}
+
 // ========================================
 // Print Operations
 // ========================================
@@ -484,6 +1244,13 @@ func (b *Bitmap) Print() string {
 	return b.PrintRange(0, b.lenBits)
 }
 
+// String implements fmt.Stringer, returning a summary such as
+// "Bitmap(len=100, count=7) 0100...0010". Bitmaps longer than 64 bits show
+// only the first and last 32 bits, so output length is bounded.
+func (b *Bitmap) String() string {
+	return b.string()
+}
+
 // PrintRange formats bits in [start, start+count) as binary string.
 // Returns empty string if count == 0.
 // Panics if start < 0, count < 0, or start+count > Len().
@@ -491,49 +1258,91 @@ func (b *Bitmap) PrintRange(start, count int) string {
 	if err := b.validateRange(start, count); err != nil {
 		panic(err.(*ValidationError).WithContext("Bitmap.PrintRange"))
 	}
-	return b.printRangeFormat(start, count, 2, false, 0, "")
+	return b.printRangeFormat(start, count, DefaultPrintOptions())
 }
 
 // PrintFormat formats all bits according to format parameters.
-// base: 2 (binary) or 16 (hexadecimal)
+// base: 2 (binary), 8 (octal), or 16 (hexadecimal)
 // grouped: insert separators between bit groups
-// groupSize: units per group (bits for base 2, hex digits for base 16)
+// groupSize: units per group (bits for base 2, digits for base 8 and 16)
 // sep: separator string
-// Panics if base not in {2,16} or grouped && groupSize <= 0.
+// Panics if base not in {2,8,16} or grouped && groupSize <= 0.
 func (b *Bitmap) PrintFormat(base int, grouped bool, groupSize int, sep string) string {
 	return b.PrintRangeFormat(0, b.lenBits, base, grouped, groupSize, sep)
 }
 
 // PrintRangeFormat formats bits in [start, start+count) with format parameters.
-// base: 2 (binary) or 16 (hexadecimal)
+// base: 2 (binary), 8 (octal), or 16 (hexadecimal)
 // grouped: insert separators between bit groups
-// groupSize: units per group (bits for base 2, hex digits for base 16)
+// groupSize: units per group (bits for base 2, digits for base 8 and 16)
 // sep: separator string
-// Panics if start < 0, count < 0, start+count > Len(), base not in {2,16},
+// Panics if start < 0, count < 0, start+count > Len(), base not in {2,8,16},
 // or grouped && groupSize <= 0.
 func (b *Bitmap) PrintRangeFormat(start, count int, base int, grouped bool, groupSize int, sep string) string {
 	if err := b.validateRange(start, count); err != nil {
 		panic(err.(*ValidationError).WithContext("Bitmap.PrintRangeFormat"))
 	}
 
-	if base != 2 && base != 16 {
-		panic(&ValidationError{
-			Field:   "base",
-			Value:   base,
-			Message: "must be 2 or 16",
-			Context: "Bitmap.PrintRangeFormat",
-		})
+	opts := DefaultPrintOptions()
+	opts.Base = base
+	opts.Grouped = grouped
+	opts.GroupSize = groupSize
+	opts.Sep = sep
+	if err := validatePrintOptions(opts); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrintRangeFormat"))
 	}
-	if grouped && groupSize <= 0 {
-		panic(&ValidationError{
-			Field:   "groupSize",
-			Value:   groupSize,
-			Message: "must be positive when grouped",
-			Context: "Bitmap.PrintRangeFormat",
-		})
+
+	return b.printRangeFormat(start, count, opts)
+}
+
+// Fprint writes bits in [start, start+count) to w, producing exactly the
+// output of PrintRangeFormat without building the full string. Output is buffered in
+// bounded chunks, so memory use does not grow with count.
+// Returns the number of bytes written and any error from w.
+// Panics if start < 0, count < 0, start+count > Len(), base not in {2,8,16},
+// or grouped && groupSize <= 0.
+func (b *Bitmap) Fprint(w io.Writer, start, count, base int, grouped bool, groupSize int, sep string) (int, error) {
+	if err := b.validateRange(start, count); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Fprint"))
+	}
+
+	opts := DefaultPrintOptions()
+	opts.Base = base
+	opts.Grouped = grouped
+	opts.GroupSize = groupSize
+	opts.Sep = sep
+	if err := validatePrintOptions(opts); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.Fprint"))
 	}
 
-	return b.printRangeFormat(start, count, base, grouped, groupSize, sep)
+	return b.fprintRange(w, start, count, opts)
+}
+
+// PrintWith formats all bits according to opts.
+// PrintWith(DefaultPrintOptions()) equals Print().
+// Panics if opts.Base not in {2,8,16} or opts.Grouped && opts.GroupSize <= 0.
+func (b *Bitmap) PrintWith(opts PrintOptions) string {
+	if err := validatePrintOptions(opts); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrintWith"))
+	}
+	return b.printRangeFormat(0, b.lenBits, opts)
+}
+
+// PrintRangeWith formats bits in [start, start+count) according to opts.
+// In base 2, set bits are drawn as opts.Set and clear bits as opts.Clear,
+// e.g. '█' and '░' to render a bar; grouping counts glyphs. The glyphs are
+// ignored in base 8 and 16. In base 16, opts.Lowercase selects a-f digits.
+// opts.LSBFirst emits bit start first instead of last within each chunk.
+// Panics if start < 0, count < 0, start+count > Len(), opts.Base not in
+// {2,8,16}, or opts.Grouped && opts.GroupSize <= 0.
+func (b *Bitmap) PrintRangeWith(start, count int, opts PrintOptions) string {
+	if err := b.validateRange(start, count); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrintRangeWith"))
+	}
+	if err := validatePrintOptions(opts); err != nil {
+		panic(err.(*ValidationError).WithContext("Bitmap.PrintRangeWith"))
+	}
+	return b.printRangeFormat(start, count, opts)
 }
 
 // ========================================
@@ -24,6 +24,26 @@ func (b *Bitmap) flipBit(i int) {
 	b.words[w] ^= uint64(1) << off
 }
 
+// setPositions sets every bit in positions to 1.
+// Consecutive positions in the same word are combined into a single store.
+// Internal implementation - no validation, no finalization.
+func (b *Bitmap) setPositions(positions []int) {
+	if len(positions) == 0 {
+		return
+	}
+
+	w := wordIdx(positions[0])
+	var acc uint64
+	for _, pos := range positions {
+		if pw := wordIdx(pos); pw != w {
+			b.words[w] |= acc
+			w, acc = pw, 0
+		}
+		acc |= uint64(1) << bitOffset(pos)
+	}
+	b.words[w] |= acc
+}
+
 // getBits extracts n bits starting from pos, returned right-aligned.
 // No validation performed - caller must ensure bounds.
 func (b *Bitmap) getBits(pos, n int) uint64 {
@@ -95,3 +115,11 @@ func (b *Bitmap) setBits(pos, n int, val uint64) {
 	highVal := maskedVal >> bitsToFirst
 	b.words[w+1] = (b.words[w+1] &^ maskSecond) | highVal
 }
+
+// appendBits grows the logical length by n and writes the low n bits of val
+// at the previous end. Internal implementation - no validation, no finalization.
+func (b *Bitmap) appendBits(n int, val uint64) {
+	pos := b.lenBits
+	b.addBits(n)
+	b.setBits(pos, n, val)
+}
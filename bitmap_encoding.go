@@ -0,0 +1,252 @@
+package btmp
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// binaryHeaderSize is the size of the length header in the binary format.
+	binaryHeaderSize = 8
+	// streamChunkWords bounds the buffer used by WriteTo and ReadFrom.
+	streamChunkWords = 512
+)
+
+// Binary format (all values little-endian):
+//
+//	[0:8)   uint64 Len() in bits
+//	[8:...) ceil(Len()/64) uint64 words, word 0 first
+//
+// Bits at indexes >= Len() in the last word are always zero.
+
+// marshalBinary encodes the bitmap into the binary format.
+// Internal implementation - no validation.
+func (b *Bitmap) marshalBinary() []byte {
+	n := wordCount(b.lenBits)
+	buf := make([]byte, binaryHeaderSize+n*8)
+	binary.LittleEndian.PutUint64(buf, uint64(b.lenBits))
+	for i := range n {
+		binary.LittleEndian.PutUint64(buf[binaryHeaderSize+i*8:], b.words[i])
+	}
+	return buf
+}
+
+// decodeBinaryHeader decodes and validates the length header.
+// Returns the logical length and the number of words that must follow.
+func decodeBinaryHeader(header []byte) (lenBits, nWords int, err error) {
+	if len(header) < binaryHeaderSize {
+		return 0, 0, &ValidationError{
+			Field:   "data",
+			Value:   fmt.Sprintf("len=%d", len(header)),
+			Message: "truncated header",
+			Err:     ErrInvalidData,
+		}
+	}
+	n := binary.LittleEndian.Uint64(header)
+	if n > math.MaxInt-IndexMask {
+		return 0, 0, &ValidationError{
+			Field:   "len",
+			Value:   n,
+			Message: "overflow",
+			Err:     ErrOverflow,
+		}
+	}
+	return int(n), wordCount(int(n)), nil
+}
+
+// unmarshalBinary decodes the binary format into b, replacing its contents.
+// Returns ValidationError on truncated or inconsistent input; b is left
+// unchanged on error.
+func (b *Bitmap) unmarshalBinary(data []byte) error {
+	lenBits, nWords, err := decodeBinaryHeader(data)
+	if err != nil {
+		return err
+	}
+	payload := data[binaryHeaderSize:]
+	if uint64(len(payload)) != uint64(nWords)*8 {
+		return &ValidationError{
+			Field:   "data",
+			Value:   fmt.Sprintf("len=%d, words=%d", len(payload), nWords),
+			Message: "payload size does not match length",
+			Err:     ErrInvalidData,
+		}
+	}
+
+	words := make([]uint64, nWords)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(payload[i*8:])
+	}
+	return b.loadWords(words, lenBits)
+}
+
+// loadWords replaces b's contents with words and lenBits after checking that
+// no bits are set beyond lenBits. b is left unchanged on error.
+func (b *Bitmap) loadWords(words []uint64, lenBits int) error {
+	if lenBits > 0 {
+		last := words[len(words)-1]
+		r := uint(lenBits) & IndexMask
+		if r != 0 && last&^MaskUpto(r) != 0 {
+			return &ValidationError{
+				Field:   "data",
+				Value:   fmt.Sprintf("len=%d", lenBits),
+				Message: "bits set beyond length",
+				Err:     ErrInvalidData,
+			}
+		}
+	}
+	b.words = words
+	b.lenBits = lenBits
+	b.computeCache()
+	return nil
+}
+
+// writeTo streams the binary format to w in bounded chunks.
+// Returns the number of bytes written and the first write error.
+func (b *Bitmap) writeTo(w io.Writer) (int64, error) {
+	var header [binaryHeaderSize]byte
+	binary.LittleEndian.PutUint64(header[:], uint64(b.lenBits))
+	n, err := w.Write(header[:])
+	total := int64(n)
+	if err != nil {
+		return total, err
+	}
+
+	nWords := wordCount(b.lenBits)
+	buf := make([]byte, min(nWords, streamChunkWords)*8)
+	for i := 0; i < nWords; {
+		k := min(nWords-i, streamChunkWords)
+		for j := range k {
+			binary.LittleEndian.PutUint64(buf[j*8:], b.words[i+j])
+		}
+		n, err := w.Write(buf[:k*8])
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+		i += k
+	}
+	return total, nil
+}
+
+// readFrom reads one bitmap in the binary format from r, replacing b's
+// contents. Storage grows as words arrive, so a corrupt header cannot force
+// a large up-front allocation. b is left unchanged on error.
+// Returns io.EOF only if r is exhausted before any byte is read.
+func (b *Bitmap) readFrom(r io.Reader) (int64, error) {
+	var header [binaryHeaderSize]byte
+	n, err := io.ReadFull(r, header[:])
+	total := int64(n)
+	if err != nil {
+		return total, err
+	}
+
+	lenBits, nWords, err := decodeBinaryHeader(header[:])
+	if err != nil {
+		return total, err
+	}
+
+	words := make([]uint64, 0, min(nWords, streamChunkWords))
+	buf := make([]byte, min(nWords, streamChunkWords)*8)
+	for len(words) < nWords {
+		k := min(nWords-len(words), streamChunkWords)
+		n, err := io.ReadFull(r, buf[:k*8])
+		total += int64(n)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return total, err
+		}
+		for j := range k {
+			words = append(words, binary.LittleEndian.Uint64(buf[j*8:]))
+		}
+	}
+
+	return total, b.loadWords(words, lenBits)
+}
+
+// bitmapJSON is the JSON representation of a Bitmap.
+// Words are hex strings ("0x...") to avoid float64 precision loss.
+type bitmapJSON struct {
+	Len   int      `json:"len"`
+	Words []string `json:"words"`
+}
+
+// marshalJSON encodes the bitmap as {"len":N,"words":["0x...",...]}.
+// Internal implementation - no validation.
+func (b *Bitmap) marshalJSON() ([]byte, error) {
+	n := wordCount(b.lenBits)
+	v := bitmapJSON{
+		Len:   b.lenBits,
+		Words: make([]string, n),
+	}
+	for i := range n {
+		v.Words[i] = "0x" + strconv.FormatUint(b.words[i], 16)
+	}
+	return json.Marshal(v)
+}
+
+// unmarshalJSON decodes the JSON representation into b, replacing its contents.
+// Returns the json error on malformed JSON and ValidationError on inconsistent
+// input; b is left unchanged on error.
+func (b *Bitmap) unmarshalJSON(data []byte) error {
+	var v bitmapJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if err := validateNonNegative(v.Len, "len"); err != nil {
+		return err
+	}
+	nWords := wordCount(v.Len)
+	if len(v.Words) != nWords {
+		return &ValidationError{
+			Field:   "words",
+			Value:   fmt.Sprintf("words=%d, len=%d", len(v.Words), v.Len),
+			Message: fmt.Sprintf("must have %d entries", nWords),
+			Err:     ErrInvalidData,
+		}
+	}
+
+	words := make([]uint64, nWords)
+	for i, s := range v.Words {
+		hex, ok := strings.CutPrefix(s, "0x")
+		w, err := strconv.ParseUint(hex, 16, 64)
+		if !ok || err != nil {
+			return &ValidationError{
+				Field:   "words",
+				Value:   s,
+				Message: "must be a 0x-prefixed 64-bit hex string",
+				Err:     ErrInvalidData,
+			}
+		}
+		words[i] = w
+	}
+	return b.loadWords(words, v.Len)
+}
+
+// toBytes encodes bits LSB-first: bit i is stored in byte i/8 at bit i%8.
+// Returns ceil(Len()/8) bytes. Internal implementation - no validation.
+func (b *Bitmap) toBytes() []byte {
+	out := make([]byte, (b.lenBits+7)/8)
+	for i := range out {
+		out[i] = byte(b.words[i/8] >> ((i % 8) * 8))
+	}
+	return out
+}
+
+// fromBytes decodes the LSB-first byte layout of toBytes into a new bitmap
+// of n bits. Bytes beyond ceil(n/8) and bits beyond n are ignored.
+// Internal implementation - caller must ensure len(data) >= ceil(n/8).
+func fromBytes(data []byte, n int) *Bitmap {
+	b := New(uint(n))
+	for i := range (n + 7) / 8 {
+		b.words[i/8] |= uint64(data[i]) << ((i % 8) * 8)
+	}
+	b.clearBeyondLen()
+	return b
+}
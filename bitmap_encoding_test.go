@@ -0,0 +1,343 @@
+package btmp_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*btmp.Bitmap)(nil)
+	_ encoding.BinaryUnmarshaler = (*btmp.Bitmap)(nil)
+	_ io.WriterTo                = (*btmp.Bitmap)(nil)
+	_ io.ReaderFrom              = (*btmp.Bitmap)(nil)
+)
+
+// limitedWriter fails once more than n bytes have been written.
+type limitedWriter struct {
+	n int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		k := w.n
+		w.n = 0
+		return k, io.ErrShortWrite
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+// TestBitmapBinaryRoundTrip validates MarshalBinary/UnmarshalBinary round-trips.
+func TestBitmapBinaryRoundTrip(t *testing.T) {
+	for _, n := range []uint{0, 1, 63, 64, 65, 100, 128, 200} {
+		b := btmp.New(n)
+		for i := 0; i < int(n); i += 3 {
+			b.SetBit(i)
+		}
+		if n > 0 {
+			b.SetBit(int(n) - 1)
+		}
+
+		data, err := b.MarshalBinary()
+		if err != nil {
+			t.Fatalf("n=%d: unexpected marshal error: %v", n, err)
+		}
+
+		var got btmp.Bitmap
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("n=%d: unexpected unmarshal error: %v", n, err)
+		}
+		if got.Len() != int(n) {
+			t.Errorf("n=%d: expected len=%d, got %d", n, n, got.Len())
+		}
+		if got.Count() != b.Count() {
+			t.Errorf("n=%d: expected count=%d, got %d", n, b.Count(), got.Count())
+		}
+		for i := range int(n) {
+			if got.Test(i) != b.Test(i) {
+				t.Errorf("n=%d: bit %d mismatch", n, i)
+				break
+			}
+		}
+	}
+}
+
+// TestBitmapUnmarshalBinaryErrors validates UnmarshalBinary error handling.
+func TestBitmapUnmarshalBinaryErrors(t *testing.T) {
+	valid, _ := btmp.New(100).SetBit(99).MarshalBinary()
+
+	t.Run("rejects truncated header", func(t *testing.T) {
+		var b btmp.Bitmap
+		if err := b.UnmarshalBinary(valid[:4]); err == nil {
+			t.Error("expected error for truncated header")
+		}
+	})
+
+	t.Run("rejects truncated payload", func(t *testing.T) {
+		var b btmp.Bitmap
+		if err := b.UnmarshalBinary(valid[:len(valid)-1]); err == nil {
+			t.Error("expected error for truncated payload")
+		}
+	})
+
+	t.Run("rejects trailing data", func(t *testing.T) {
+		var b btmp.Bitmap
+		if err := b.UnmarshalBinary(append(valid, 0)); err == nil {
+			t.Error("expected error for trailing data")
+		}
+	})
+
+	t.Run("rejects bits beyond length", func(t *testing.T) {
+		data := append([]byte(nil), valid...)
+		data[len(data)-1] = 0x80 // bit 127, beyond len=100
+		var b btmp.Bitmap
+		if err := b.UnmarshalBinary(data); err == nil {
+			t.Error("expected error for bits beyond length")
+		}
+	})
+
+	t.Run("leaves receiver unchanged on error", func(t *testing.T) {
+		b := btmp.New(10).SetBit(3)
+		_ = b.UnmarshalBinary(valid[:4])
+		if b.Len() != 10 || !b.Test(3) {
+			t.Error("expected receiver unchanged after error")
+		}
+	})
+}
+
+// TestBitmapWriteToReadFrom validates streaming WriteTo/ReadFrom.
+func TestBitmapWriteToReadFrom(t *testing.T) {
+	t.Run("output matches MarshalBinary", func(t *testing.T) {
+		b := btmp.New(100_000)
+		b.SetRange(1000, 5000).SetBit(99_999)
+
+		var buf bytes.Buffer
+		n, err := b.WriteTo(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want, _ := b.MarshalBinary()
+		if n != int64(len(want)) {
+			t.Errorf("expected n=%d, got %d", len(want), n)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Error("expected WriteTo output to equal MarshalBinary output")
+		}
+	})
+
+	t.Run("round-trips through ReadFrom", func(t *testing.T) {
+		b := btmp.New(100_000)
+		b.SetRange(1000, 5000).SetBit(99_999)
+
+		var buf bytes.Buffer
+		written, _ := b.WriteTo(&buf)
+
+		got := btmp.New(3)
+		read, err := got.ReadFrom(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if read != written {
+			t.Errorf("expected read=%d, got %d", written, read)
+		}
+		if got.Len() != 100_000 || got.Count() != 5001 || !got.Test(99_999) {
+			t.Error("expected round-tripped bitmap to match")
+		}
+	})
+
+	t.Run("reports partial write", func(t *testing.T) {
+		b := btmp.New(256)
+		n, err := b.WriteTo(&limitedWriter{n: 12})
+		if err == nil {
+			t.Fatal("expected error for short write")
+		}
+		if n != 12 {
+			t.Errorf("expected n=12, got %d", n)
+		}
+	})
+
+	t.Run("reports partial read", func(t *testing.T) {
+		data, _ := btmp.New(256).MarshalBinary()
+		b := btmp.New(10).SetBit(1)
+		n, err := b.ReadFrom(bytes.NewReader(data[:20]))
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+		}
+		if n != 20 {
+			t.Errorf("expected n=20, got %d", n)
+		}
+		if b.Len() != 10 || !b.Test(1) {
+			t.Error("expected receiver unchanged after error")
+		}
+	})
+
+	t.Run("returns EOF on empty reader", func(t *testing.T) {
+		var b btmp.Bitmap
+		if _, err := b.ReadFrom(bytes.NewReader(nil)); err != io.EOF {
+			t.Errorf("expected io.EOF, got %v", err)
+		}
+	})
+}
+
+// TestBitmapJSON validates MarshalJSON/UnmarshalJSON.
+func TestBitmapJSON(t *testing.T) {
+	t.Run("encodes len and hex words", func(t *testing.T) {
+		b := btmp.New(68)
+		b.SetBits(0, 4, 0xF).SetBit(67)
+
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := `{"len":68,"words":["0xf","0x8"]}`
+		if string(data) != want {
+			t.Errorf("expected %s, got %s", want, data)
+		}
+	})
+
+	t.Run("round-trips inside a struct", func(t *testing.T) {
+		type config struct {
+			Mask *btmp.Bitmap `json:"mask"`
+		}
+		b := btmp.New(200)
+		b.SetRange(60, 80).SetBit(199)
+
+		data, err := json.Marshal(config{Mask: b})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var got config
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Mask.Len() != 200 || got.Mask.Count() != 81 || !got.Mask.AllRange(60, 80) {
+			t.Error("expected round-tripped bitmap to match")
+		}
+	})
+
+	t.Run("round-trips empty bitmap", func(t *testing.T) {
+		data, _ := json.Marshal(btmp.New(0))
+		var got btmp.Bitmap
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Len() != 0 {
+			t.Errorf("expected len=0, got %d", got.Len())
+		}
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		inputs := []string{
+			`{"len":-1,"words":[]}`,
+			`{"len":65,"words":["0x0"]}`,
+			`{"len":64,"words":["0x0","0x0"]}`,
+			`{"len":4,"words":["0x10"]}`,
+			`{"len":4,"words":["15"]}`,
+			`{"len":4,"words":["0xzz"]}`,
+			`{"len":"4","words":[]}`,
+		}
+		for _, in := range inputs {
+			var b btmp.Bitmap
+			if err := json.Unmarshal([]byte(in), &b); err == nil {
+				t.Errorf("expected error for %s", in)
+			}
+		}
+	})
+}
+
+// TestBitmapGob validates GobEncode/GobDecode.
+func TestBitmapGob(t *testing.T) {
+	t.Run("round-trips through gob", func(t *testing.T) {
+		type message struct {
+			ID   int
+			Mask *btmp.Bitmap
+		}
+		b := btmp.New(130)
+		b.SetRange(3, 70).SetBit(129)
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(message{ID: 7, Mask: b}); err != nil {
+			t.Fatalf("unexpected encode error: %v", err)
+		}
+		var got message
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Fatalf("unexpected decode error: %v", err)
+		}
+		if got.Mask.Len() != 130 || got.Mask.Count() != 71 || !got.Mask.Test(129) {
+			t.Error("expected round-tripped bitmap to match")
+		}
+	})
+
+	t.Run("round-trips empty bitmap", func(t *testing.T) {
+		data, _ := btmp.New(0).GobEncode()
+		got := btmp.New(10)
+		if err := got.GobDecode(data); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Len() != 0 || got.Any() {
+			t.Error("expected empty bitmap")
+		}
+	})
+
+	t.Run("rejects malformed buffer", func(t *testing.T) {
+		var b btmp.Bitmap
+		if err := b.GobDecode([]byte{1, 2, 3}); err == nil {
+			t.Error("expected error for malformed buffer")
+		}
+	})
+}
+
+// TestBitmapBytes validates ToBytes/FromBytes byte layout.
+func TestBitmapBytes(t *testing.T) {
+	t.Run("uses LSB-first byte layout", func(t *testing.T) {
+		b := btmp.New(20).SetBit(0).SetBit(9).SetBit(19)
+		got := b.ToBytes()
+		want := []byte{0x01, 0x02, 0x08}
+		if !bytes.Equal(got, want) {
+			t.Errorf("expected %x, got %x", want, got)
+		}
+	})
+
+	t.Run("round-trips across word boundaries", func(t *testing.T) {
+		b := btmp.New(150).SetRange(60, 10).SetBit(149)
+		got := btmp.FromBytes(b.ToBytes(), 150)
+		if got.Len() != 150 || got.Count() != 11 || !got.AllRange(60, 10) || !got.Test(149) {
+			t.Error("expected round-tripped bitmap to match")
+		}
+	})
+
+	t.Run("ignores bits beyond n", func(t *testing.T) {
+		b := btmp.FromBytes([]byte{0xFF, 0xFF, 0xFF}, 12)
+		if b.Count() != 12 {
+			t.Errorf("expected count=12, got %d", b.Count())
+		}
+		if got := b.ToBytes(); !bytes.Equal(got, []byte{0xFF, 0x0F}) {
+			t.Errorf("expected ff0f, got %x", got)
+		}
+	})
+
+	t.Run("empty bitmap", func(t *testing.T) {
+		if got := btmp.New(0).ToBytes(); len(got) != 0 {
+			t.Errorf("expected no bytes, got %x", got)
+		}
+		if b := btmp.FromBytes(nil, 0); b.Len() != 0 {
+			t.Errorf("expected len=0, got %d", b.Len())
+		}
+	})
+
+	t.Run("panics when data too short", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for short data")
+			}
+		}()
+		btmp.FromBytes([]byte{0xFF}, 9)
+	})
+}
@@ -20,6 +20,28 @@ func (b *Bitmap) ensureBits(n int) {
 	b.lenBits = n
 }
 
+// concat grows the logical length by other.Len() and copies other's bits into
+// the appended region. Safe when other == b.
+// Internal implementation - no validation, no finalization.
+func (b *Bitmap) concat(other *Bitmap) {
+	n := other.lenBits
+	if n == 0 {
+		return
+	}
+	pos := b.lenBits
+	b.addBits(n)
+	b.copyRange(other, 0, pos, n)
+}
+
+// reserve grows the backing capacity to hold at least n bits without changing
+// the logical length. Internal implementation - caller must ensure n >= 0.
+func (b *Bitmap) reserve(n int) {
+	need := wordCount(n)
+	if need > cap(b.words) {
+		b.words = slices.Grow(b.words, need-len(b.words))
+	}
+}
+
 // addBits grows the logical length by n bits without validation.
 // Internal implementation - no bounds checking, no finalization.
 // Caller must ensure n >= 0 and handle finalization.
@@ -29,3 +51,80 @@ func (b *Bitmap) addBits(n int) {
 	}
 	b.ensureBits(b.lenBits + n)
 }
+
+// truncate shrinks the logical length to n bits without validation.
+// Bits in [n, Len()) are cleared so they stay zero beyond the new length.
+// The backing slice keeps its length. No-op if n >= Len().
+// Internal implementation - caller must ensure n >= 0 and handle finalization.
+func (b *Bitmap) truncate(n int) {
+	if n >= b.lenBits {
+		return
+	}
+	b.clearRange(n, b.lenBits-n)
+	b.lenBits = n
+}
+
+// resize sets the logical length to exactly n bits without validation.
+// Grows like ensureBits (new bits zero) or shrinks like truncate.
+// Internal implementation - caller must ensure n >= 0 and handle finalization.
+func (b *Bitmap) resize(n int) {
+	if n > b.lenBits {
+		b.ensureBits(n)
+		return
+	}
+	b.truncate(n)
+}
+
+// copyFrom resizes b to other.Len() and overwrites all of its bits with
+// other's. No-op if other == b.
+// Internal implementation - no validation, no finalization.
+func (b *Bitmap) copyFrom(other *Bitmap) {
+	if other == b {
+		return
+	}
+	b.resize(other.lenBits)
+	n := wordCount(other.lenBits)
+	copy(b.words[:n], other.words[:n])
+}
+
+// shrinkToFit reallocates words to exactly the logical word count, copying
+// live words. No-op if the backing capacity is already exact.
+// Internal implementation - caller must handle finalization.
+func (b *Bitmap) shrinkToFit() {
+	need := wordCount(b.lenBits)
+	if cap(b.words) == need {
+		b.words = b.words[:need]
+		return
+	}
+	words := make([]uint64, need)
+	copy(words, b.words)
+	b.words = words
+}
+
+// reset clears all bits and sets the logical length to 0 without releasing
+// capacity. The words slice is resliced to length 0, so later growth reuses
+// the backing array (ensureBits zeroes reclaimed words).
+// Internal implementation - caller must handle finalization.
+func (b *Bitmap) reset() {
+	b.clearAll()
+	b.words = b.words[:0]
+	b.lenBits = 0
+}
+
+// slice returns a new bitmap holding a copy of bits [start, start+count).
+// Internal implementation - no validation.
+func (b *Bitmap) slice(start, count int) *Bitmap {
+	c := New(uint(count))
+	c.copyRange(b, start, 0, count)
+	return c
+}
+
+// clearBeyondLen zeroes every stored bit at index >= Len(), including whole
+// words past the last logical word.
+// Internal implementation - requires an up-to-date cache.
+func (b *Bitmap) clearBeyondLen() {
+	if b.lenBits > 0 {
+		b.words[b.lastWordIdx] &= b.tailMask
+	}
+	clear(b.words[b.lastWordIdx+1:])
+}
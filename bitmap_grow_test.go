@@ -0,0 +1,341 @@
+package btmp_test
+
+import (
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestBitmapTruncate validates Bitmap.Truncate() shrink operation.
+func TestBitmapTruncate(t *testing.T) {
+	t.Run("shrinks length and keeps lower bits", func(t *testing.T) {
+		b := btmp.New(200).SetAll()
+		b.Truncate(70)
+
+		if b.Len() != 70 {
+			t.Errorf("expected len=70, got %d", b.Len())
+		}
+		if b.Count() != 70 || !b.All() {
+			t.Errorf("expected all 70 bits set, got count=%d", b.Count())
+		}
+	})
+
+	t.Run("clears discarded bits", func(t *testing.T) {
+		b := btmp.New(200).SetAll()
+		b.Truncate(70).EnsureBits(200)
+
+		if b.Count() != 70 {
+			t.Errorf("expected regrown bits to be zero, got count=%d", b.Count())
+		}
+		if b.AnyRange(70, 130) {
+			t.Error("expected [70, 200) clear after regrowth")
+		}
+	})
+
+	t.Run("keeps backing storage", func(t *testing.T) {
+		b := btmp.New(200)
+		b.Truncate(10)
+		if len(b.Words()) != 4 {
+			t.Errorf("expected 4 words retained, got %d", len(b.Words()))
+		}
+	})
+
+	t.Run("truncate to zero", func(t *testing.T) {
+		b := btmp.New(100).SetAll().Truncate(0)
+		if b.Len() != 0 || b.Any() || b.Count() != 0 {
+			t.Error("expected empty bitmap")
+		}
+	})
+
+	t.Run("no-op when n >= Len", func(t *testing.T) {
+		b := btmp.New(100).SetBit(99)
+		b.Truncate(100).Truncate(500)
+		if b.Len() != 100 || !b.Test(99) {
+			t.Error("expected bitmap unchanged")
+		}
+	})
+
+	t.Run("panics on negative n", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative n")
+			}
+		}()
+		btmp.New(10).Truncate(-1)
+	})
+}
+
+// TestBitmapResize validates Bitmap.Resize() grow and shrink behavior.
+func TestBitmapResize(t *testing.T) {
+	t.Run("grows with zero bits", func(t *testing.T) {
+		b := btmp.New(10).SetAll()
+		b.Resize(130)
+		if b.Len() != 130 {
+			t.Errorf("expected len=130, got %d", b.Len())
+		}
+		if b.Count() != 10 || b.AnyRange(10, 120) {
+			t.Error("expected only original bits set")
+		}
+	})
+
+	t.Run("shrinks and clears discarded bits", func(t *testing.T) {
+		b := btmp.New(130).SetAll()
+		b.Resize(65)
+		if b.Len() != 65 || b.Count() != 65 {
+			t.Errorf("expected len=65 count=65, got len=%d count=%d", b.Len(), b.Count())
+		}
+		b.Resize(130)
+		if b.Count() != 65 {
+			t.Errorf("expected count=65 after regrowth, got %d", b.Count())
+		}
+	})
+
+	t.Run("no-op for same length", func(t *testing.T) {
+		b := btmp.New(64).SetBit(63).Resize(64)
+		if b.Len() != 64 || !b.Test(63) {
+			t.Error("expected bitmap unchanged")
+		}
+	})
+
+	t.Run("panics on negative n", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative n")
+			}
+		}()
+		btmp.New(10).Resize(-1)
+	})
+}
+
+// TestBitmapReset validates Bitmap.Reset() reuse behavior.
+func TestBitmapReset(t *testing.T) {
+	t.Run("behaves like New(0)", func(t *testing.T) {
+		b := btmp.New(200).SetAll().Reset()
+		if b.Len() != 0 || len(b.Words()) != 0 {
+			t.Errorf("expected len=0 words=0, got len=%d words=%d", b.Len(), len(b.Words()))
+		}
+		if b.Any() || !b.All() || b.Count() != 0 {
+			t.Error("expected empty bitmap semantics")
+		}
+	})
+
+	t.Run("regrowth yields zero bits", func(t *testing.T) {
+		b := btmp.New(200).SetAll().Reset().EnsureBits(200)
+		if b.Count() != 0 {
+			t.Errorf("expected count=0, got %d", b.Count())
+		}
+	})
+
+	t.Run("regrowth does not allocate", func(t *testing.T) {
+		b := btmp.New(1024)
+		allocs := testing.AllocsPerRun(100, func() {
+			b.Reset().EnsureBits(1024).SetBit(1000)
+		})
+		if allocs != 0 {
+			t.Errorf("expected 0 allocations, got %v", allocs)
+		}
+	})
+}
+
+// TestBitmapReserve validates Bitmap.Reserve() and Bitmap.Cap().
+func TestBitmapReserve(t *testing.T) {
+	t.Run("grows capacity without changing length", func(t *testing.T) {
+		b := btmp.New(10).SetBit(3)
+		b.Reserve(1000)
+		if b.Cap() < 1000 {
+			t.Errorf("expected cap >= 1000, got %d", b.Cap())
+		}
+		if b.Len() != 10 || b.Count() != 1 || !b.Test(3) {
+			t.Error("expected length and bits unchanged")
+		}
+	})
+
+	t.Run("subsequent growth is allocation-free", func(t *testing.T) {
+		b := btmp.New(0)
+		b.Reserve(64 * 100)
+		allocs := testing.AllocsPerRun(10, func() {
+			b.Reset()
+			for range 100 {
+				b.AddBits(64)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("expected 0 allocations, got %v", allocs)
+		}
+		if b.Count() != 0 {
+			t.Errorf("expected count=0, got %d", b.Count())
+		}
+	})
+
+	t.Run("no-op when capacity suffices", func(t *testing.T) {
+		b := btmp.New(256)
+		before := b.Cap()
+		b.Reserve(10)
+		if b.Cap() != before {
+			t.Errorf("expected cap=%d, got %d", before, b.Cap())
+		}
+	})
+
+	t.Run("panics on negative n", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative n")
+			}
+		}()
+		btmp.New(10).Reserve(-1)
+	})
+}
+
+// TestBitmapShrinkToFit validates Bitmap.ShrinkToFit() memory release.
+func TestBitmapShrinkToFit(t *testing.T) {
+	t.Run("releases surplus words", func(t *testing.T) {
+		b := btmp.New(1000).SetRange(0, 70)
+		b.Truncate(100).ShrinkToFit()
+
+		if len(b.Words()) != 2 || b.Cap() != 128 {
+			t.Errorf("expected 2 words cap=128, got words=%d cap=%d", len(b.Words()), b.Cap())
+		}
+		if b.Len() != 100 || b.Count() != 70 || !b.AllRange(0, 70) {
+			t.Error("expected length and bits preserved")
+		}
+	})
+
+	t.Run("no-op when already exact", func(t *testing.T) {
+		b := btmp.New(128).SetBit(127)
+		before := &b.Words()[0]
+		b.ShrinkToFit()
+		if &b.Words()[0] != before {
+			t.Error("expected storage to be retained")
+		}
+	})
+
+	t.Run("empty bitmap", func(t *testing.T) {
+		b := btmp.New(500).Reset().ShrinkToFit()
+		if b.Cap() != 0 || b.Len() != 0 {
+			t.Errorf("expected cap=0 len=0, got cap=%d len=%d", b.Cap(), b.Len())
+		}
+	})
+}
+
+// TestBitmapConcat validates Bitmap.Concat() append behavior.
+func TestBitmapConcat(t *testing.T) {
+	t.Run("appends bits at unaligned offset", func(t *testing.T) {
+		b := btmp.New(70).SetBit(0).SetBit(69)
+		other := btmp.New(100).SetBit(0).SetRange(50, 50)
+
+		b.Concat(other)
+
+		if b.Len() != 170 {
+			t.Errorf("expected len=170, got %d", b.Len())
+		}
+		if !b.Test(0) || !b.Test(69) || !b.Test(70) || !b.AllRange(120, 50) {
+			t.Error("expected bits from both bitmaps")
+		}
+		if b.Count() != 53 {
+			t.Errorf("expected count=53, got %d", b.Count())
+		}
+		if other.Len() != 100 || other.Count() != 51 {
+			t.Error("expected other unchanged")
+		}
+	})
+
+	t.Run("no-op for empty other", func(t *testing.T) {
+		b := btmp.New(10).SetBit(9).Concat(btmp.New(0))
+		if b.Len() != 10 || b.Count() != 1 {
+			t.Error("expected bitmap unchanged")
+		}
+	})
+
+	t.Run("concat with itself", func(t *testing.T) {
+		b := btmp.New(40).SetRange(0, 3)
+		b.Concat(b)
+		if b.Len() != 80 || b.Count() != 6 || !b.AllRange(40, 3) {
+			t.Errorf("expected doubled pattern, got len=%d count=%d", b.Len(), b.Count())
+		}
+	})
+
+	t.Run("panics on nil other", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil other")
+			}
+		}()
+		btmp.New(10).Concat(nil)
+	})
+}
+
+// TestBitmapConcatOntoEmpty validates Concat when the receiver is empty.
+func TestBitmapConcatOntoEmpty(t *testing.T) {
+	b := btmp.New(0).Concat(btmp.New(70).SetRange(5, 60))
+	if b.Len() != 70 || b.Count() != 60 || !b.AllRange(5, 60) {
+		t.Errorf("expected copied bits, got len=%d count=%d", b.Len(), b.Count())
+	}
+}
+
+// TestBitmapCopyFrom validates Bitmap.CopyFrom() overwrite semantics.
+func TestBitmapCopyFrom(t *testing.T) {
+	sameBits := func(t *testing.T, got, want *btmp.Bitmap) {
+		t.Helper()
+		if got.Len() != want.Len() {
+			t.Fatalf("expected len=%d, got %d", want.Len(), got.Len())
+		}
+		for i := range want.Len() {
+			if got.Test(i) != want.Test(i) {
+				t.Fatalf("bit %d mismatch", i)
+			}
+		}
+	}
+
+	t.Run("grows to match larger source", func(t *testing.T) {
+		src := btmp.New(200).SetRange(50, 100)
+		dst := btmp.New(10).SetAll()
+		dst.CopyFrom(src)
+		sameBits(t, dst, src)
+	})
+
+	t.Run("shrinks to match smaller source", func(t *testing.T) {
+		src := btmp.New(70).SetBit(0).SetBit(69)
+		dst := btmp.New(300).SetAll()
+		dst.CopyFrom(src)
+		sameBits(t, dst, src)
+		if dst.Count() != 2 {
+			t.Errorf("expected count=2, got %d", dst.Count())
+		}
+		dst.EnsureBits(300)
+		if dst.Count() != 2 {
+			t.Errorf("expected no stale bits after regrow, got count=%d", dst.Count())
+		}
+	})
+
+	t.Run("copies empty source", func(t *testing.T) {
+		dst := btmp.New(64).SetAll().CopyFrom(btmp.New(0))
+		if dst.Len() != 0 {
+			t.Errorf("expected len=0, got %d", dst.Len())
+		}
+	})
+
+	t.Run("leaves source unchanged", func(t *testing.T) {
+		src := btmp.New(100).SetRange(3, 7)
+		btmp.New(5).CopyFrom(src).SetAll()
+		if src.Count() != 7 {
+			t.Errorf("expected source count=7, got %d", src.Count())
+		}
+	})
+
+	t.Run("self copy is a no-op", func(t *testing.T) {
+		b := btmp.New(100).SetRange(3, 7)
+		b.CopyFrom(b)
+		if b.Len() != 100 || b.Count() != 7 {
+			t.Errorf("expected unchanged bitmap, got len=%d count=%d", b.Len(), b.Count())
+		}
+	})
+
+	t.Run("panics on nil other", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil other")
+			}
+		}()
+		btmp.New(10).CopyFrom(nil)
+	})
+}
@@ -1,5 +1,7 @@
 package btmp
 
+import "math/bits"
+
 // and performs bitwise AND with other bitmap.
 // Internal implementation - no validation, no finalization.
 // Assumes same length and sufficient capacity.
@@ -51,6 +53,73 @@ func (b *Bitmap) xor(other *Bitmap) {
 	b.words[b.lastWordIdx] = (b.words[b.lastWordIdx] ^ other.words[b.lastWordIdx]) & b.tailMask
 }
 
+// orAt ORs other's bits [0, other.Len()) onto b's bits [offset, offset+other.Len()).
+// Internal implementation - no validation, no finalization.
+// Assumes offset+other.Len() <= Len().
+func (b *Bitmap) orAt(offset int, other *Bitmap) {
+	b.combineAt(offset, other, false)
+}
+
+// xorAt XORs other's bits [0, other.Len()) onto b's bits [offset, offset+other.Len()).
+// Internal implementation - no validation, no finalization.
+// Assumes offset+other.Len() <= Len().
+func (b *Bitmap) xorAt(offset int, other *Bitmap) {
+	b.combineAt(offset, other, true)
+}
+
+// combineAt applies OR (or XOR when xor is set) of other onto b at offset.
+// Bits of other beyond its length are zero, so whole-word application leaves
+// b's bits outside the target window untouched.
+// Internal implementation - no validation, no finalization.
+func (b *Bitmap) combineAt(offset int, other *Bitmap, xor bool) {
+	n := other.lenBits
+	if n == 0 {
+		return
+	}
+
+	// Fast path: word-aligned target, combine whole words directly
+	if offset&IndexMask == 0 {
+		dst := b.words[offset>>WordShift:]
+		for i := range wordCount(n) {
+			if xor {
+				dst[i] ^= other.words[i]
+			} else {
+				dst[i] |= other.words[i]
+			}
+		}
+		return
+	}
+
+	// Unaligned target: combine in chunks of up to 64 bits
+	for pos := 0; pos < n; pos += WordBits {
+		k := min(WordBits, n-pos)
+		v := other.getBits(pos, k)
+		cur := b.getBits(offset+pos, k)
+		if xor {
+			b.setBits(offset+pos, k, cur^v)
+		} else {
+			b.setBits(offset+pos, k, cur|v)
+		}
+	}
+}
+
+// andNot clears bits in b that are set in other (b &^= other).
+// Internal implementation - no validation, no finalization.
+// Assumes same length and sufficient capacity.
+func (b *Bitmap) andNot(other *Bitmap) {
+	if b.lenBits == 0 {
+		return
+	}
+
+	// Process full words
+	for i := range b.lastWordIdx {
+		b.words[i] &^= other.words[i]
+	}
+
+	// Process last partial word with proper masking
+	b.words[b.lastWordIdx] = (b.words[b.lastWordIdx] &^ other.words[b.lastWordIdx]) & b.tailMask
+}
+
 // not performs bitwise NOT (flips all bits in [0, Len())).
 // Internal implementation - no validation, no finalization.
 func (b *Bitmap) not() {
@@ -66,3 +135,149 @@ func (b *Bitmap) not() {
 	// Process last partial word with proper masking
 	b.words[b.lastWordIdx] = (^b.words[b.lastWordIdx]) & b.tailMask
 }
+
+// intersects reports whether b and other share any set bit in [0, Len()).
+// Internal implementation - no validation.
+// Assumes same length.
+func (b *Bitmap) intersects(other *Bitmap) bool {
+	if b.lenBits == 0 {
+		return false
+	}
+
+	// Process full words
+	for i := range b.lastWordIdx {
+		if b.words[i]&other.words[i] != 0 {
+			return true
+		}
+	}
+
+	// Process last partial word with proper masking
+	return (b.words[b.lastWordIdx]&other.words[b.lastWordIdx])&b.tailMask != 0
+}
+
+// isSubsetOf reports whether every set bit of b is also set in other.
+// Internal implementation - no validation.
+// Assumes same length.
+func (b *Bitmap) isSubsetOf(other *Bitmap) bool {
+	if b.lenBits == 0 {
+		return true
+	}
+
+	// Process full words
+	for i := range b.lastWordIdx {
+		if b.words[i]&^other.words[i] != 0 {
+			return false
+		}
+	}
+
+	// Process last partial word with proper masking
+	return (b.words[b.lastWordIdx]&^other.words[b.lastWordIdx])&b.tailMask == 0
+}
+
+// andCount returns the number of set bits in b AND other over [0, Len()).
+// Internal implementation - no validation.
+// Assumes same length.
+func (b *Bitmap) andCount(other *Bitmap) int {
+	if b.lenBits == 0 {
+		return 0
+	}
+
+	sum := 0
+	// Process full words
+	for i := range b.lastWordIdx {
+		sum += bits.OnesCount64(b.words[i] & other.words[i])
+	}
+
+	// Process last partial word with proper masking
+	return sum + bits.OnesCount64((b.words[b.lastWordIdx]&other.words[b.lastWordIdx])&b.tailMask)
+}
+
+// orCount returns the number of set bits in b OR other over [0, Len()).
+// Internal implementation - no validation.
+// Assumes same length.
+func (b *Bitmap) orCount(other *Bitmap) int {
+	if b.lenBits == 0 {
+		return 0
+	}
+
+	sum := 0
+	// Process full words
+	for i := range b.lastWordIdx {
+		sum += bits.OnesCount64(b.words[i] | other.words[i])
+	}
+
+	// Process last partial word with proper masking
+	return sum + bits.OnesCount64((b.words[b.lastWordIdx]|other.words[b.lastWordIdx])&b.tailMask)
+}
+
+// xorCount returns the number of set bits in b XOR other over [0, Len()).
+// Internal implementation - no validation.
+// Assumes same length.
+func (b *Bitmap) xorCount(other *Bitmap) int {
+	if b.lenBits == 0 {
+		return 0
+	}
+
+	sum := 0
+	// Process full words
+	for i := range b.lastWordIdx {
+		sum += bits.OnesCount64(b.words[i] ^ other.words[i])
+	}
+
+	// Process last partial word with proper masking
+	return sum + bits.OnesCount64((b.words[b.lastWordIdx]^other.words[b.lastWordIdx])&b.tailMask)
+}
+
+// equal reports whether b and other have the same length and set bits.
+// Internal implementation - no validation.
+func (b *Bitmap) equal(other *Bitmap) bool {
+	if b.lenBits != other.lenBits {
+		return false
+	}
+	if b.lenBits == 0 {
+		return true
+	}
+
+	// Process full words
+	for i := range b.lastWordIdx {
+		if b.words[i] != other.words[i] {
+			return false
+		}
+	}
+
+	// Process last partial word with proper masking
+	return (b.words[b.lastWordIdx]^other.words[b.lastWordIdx])&b.tailMask == 0
+}
+
+// FNV-1a 64-bit parameters.
+const (
+	fnvOffset64 uint64 = 14695981039346656037
+	fnvPrime64  uint64 = 1099511628211
+)
+
+// fnvMix folds the 8 little-endian bytes of v into the FNV-1a state h.
+func fnvMix(h, v uint64) uint64 {
+	for range 8 {
+		h ^= v & 0xFF
+		h *= fnvPrime64
+		v >>= 8
+	}
+	return h
+}
+
+// hash returns the FNV-1a hash of Len() followed by the tail-masked logical words.
+// Internal implementation - no validation.
+func (b *Bitmap) hash() uint64 {
+	h := fnvMix(fnvOffset64, uint64(b.lenBits))
+	if b.lenBits == 0 {
+		return h
+	}
+
+	// Process full words
+	for i := range b.lastWordIdx {
+		h = fnvMix(h, b.words[i])
+	}
+
+	// Process last partial word with proper masking
+	return fnvMix(h, b.words[b.lastWordIdx]&b.tailMask)
+}
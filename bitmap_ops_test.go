@@ -0,0 +1,411 @@
+package btmp_test
+
+import (
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestBitmapAndNot validates Bitmap.AndNot() logical operation.
+func TestBitmapAndNot(t *testing.T) {
+	t.Run("clears bits set in other", func(t *testing.T) {
+		b := btmp.New(100).SetRange(0, 100)
+		mask := btmp.New(100).SetRange(10, 20).SetBit(99)
+
+		b.AndNot(mask)
+
+		if b.Count() != 79 {
+			t.Errorf("expected count=79, got %d", b.Count())
+		}
+		if b.AnyRange(10, 20) {
+			t.Error("expected [10, 30) cleared")
+		}
+		if b.Test(99) {
+			t.Error("expected bit 99 cleared")
+		}
+		if !b.Test(9) || !b.Test(30) {
+			t.Error("expected bits outside mask preserved")
+		}
+	})
+
+	t.Run("leaves other unchanged", func(t *testing.T) {
+		b := btmp.New(64).SetAll()
+		mask := btmp.New(64).SetBit(5)
+
+		b.AndNot(mask)
+
+		if mask.Count() != 1 || !mask.Test(5) {
+			t.Error("expected other unchanged")
+		}
+	})
+
+	t.Run("no-op on empty bitmaps", func(t *testing.T) {
+		b := btmp.New(0)
+		b.AndNot(btmp.New(0))
+		if b.Len() != 0 {
+			t.Errorf("expected len=0, got %d", b.Len())
+		}
+	})
+
+	t.Run("panics on nil other", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil other")
+			}
+		}()
+		btmp.New(10).AndNot(nil)
+	})
+
+	t.Run("panics on length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for length mismatch")
+			}
+		}()
+		btmp.New(10).AndNot(btmp.New(11))
+	})
+}
+
+// TestBitmapLogicalNew validates AndNew/OrNew/XorNew non-mutating operations.
+func TestBitmapLogicalNew(t *testing.T) {
+	newOperands := func() (*btmp.Bitmap, *btmp.Bitmap) {
+		a := btmp.New(100).SetRange(0, 60)
+		b := btmp.New(100).SetRange(40, 60)
+		return a, b
+	}
+
+	tests := []struct {
+		name  string
+		op    func(a, b *btmp.Bitmap) *btmp.Bitmap
+		count int
+	}{
+		{"AndNew", (*btmp.Bitmap).AndNew, 20},
+		{"OrNew", (*btmp.Bitmap).OrNew, 100},
+		{"XorNew", (*btmp.Bitmap).XorNew, 80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" returns combined result", func(t *testing.T) {
+			a, b := newOperands()
+			res := tt.op(a, b)
+			if res.Len() != 100 {
+				t.Errorf("expected len=100, got %d", res.Len())
+			}
+			if res.Count() != tt.count {
+				t.Errorf("expected count=%d, got %d", tt.count, res.Count())
+			}
+		})
+
+		t.Run(tt.name+" leaves operands unchanged", func(t *testing.T) {
+			a, b := newOperands()
+			res := tt.op(a, b)
+			res.SetAll()
+			if a.Count() != 60 || !a.AllRange(0, 60) {
+				t.Error("expected receiver unchanged")
+			}
+			if b.Count() != 60 || !b.AllRange(40, 60) {
+				t.Error("expected other unchanged")
+			}
+		})
+
+		t.Run(tt.name+" panics on length mismatch", func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected panic for length mismatch")
+				}
+			}()
+			tt.op(btmp.New(10), btmp.New(20))
+		})
+	}
+}
+
+// TestBitmapIntersects validates Bitmap.Intersects() overlap test.
+func TestBitmapIntersects(t *testing.T) {
+	t.Run("detects shared bit", func(t *testing.T) {
+		a := btmp.New(200).SetRange(0, 10).SetBit(150)
+		b := btmp.New(200).SetBit(150)
+		if !a.Intersects(b) || !b.Intersects(a) {
+			t.Error("expected intersection")
+		}
+	})
+
+	t.Run("disjoint bitmaps", func(t *testing.T) {
+		a := btmp.New(200).SetRange(0, 100)
+		b := btmp.New(200).SetRange(100, 100)
+		if a.Intersects(b) {
+			t.Error("expected no intersection")
+		}
+	})
+
+	t.Run("empty bitmaps", func(t *testing.T) {
+		if btmp.New(0).Intersects(btmp.New(0)) {
+			t.Error("expected no intersection")
+		}
+	})
+
+	t.Run("panics on length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for length mismatch")
+			}
+		}()
+		btmp.New(10).Intersects(btmp.New(11))
+	})
+}
+
+// TestBitmapIsSubsetOf validates Bitmap.IsSubsetOf() predicate.
+func TestBitmapIsSubsetOf(t *testing.T) {
+	small := btmp.New(130).SetRange(10, 5).SetBit(129)
+	big := btmp.New(130).SetRange(0, 20).SetBit(129)
+
+	if !small.IsSubsetOf(big) {
+		t.Error("expected small to be subset of big")
+	}
+	if big.IsSubsetOf(small) {
+		t.Error("expected big not to be subset of small")
+	}
+	if !small.IsSubsetOf(small) {
+		t.Error("expected bitmap to be subset of itself")
+	}
+	if !btmp.New(130).IsSubsetOf(small) {
+		t.Error("expected empty set to be subset")
+	}
+	if !btmp.New(0).IsSubsetOf(btmp.New(0)) {
+		t.Error("expected empty bitmaps to be subsets")
+	}
+}
+
+// TestBitmapIsDisjoint validates Bitmap.IsDisjoint() predicate.
+func TestBitmapIsDisjoint(t *testing.T) {
+	a := btmp.New(130).SetRange(0, 64)
+	b := btmp.New(130).SetRange(64, 66)
+
+	if !a.IsDisjoint(b) {
+		t.Error("expected disjoint bitmaps")
+	}
+	b.SetBit(63)
+	if a.IsDisjoint(b) {
+		t.Error("expected overlapping bitmaps")
+	}
+
+	t.Run("panics on length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for length mismatch")
+			}
+		}()
+		a.IsDisjoint(btmp.New(10))
+	})
+}
+
+// TestBitmapHammingDistance validates Bitmap.HammingDistance() metric.
+func TestBitmapHammingDistance(t *testing.T) {
+	a := btmp.New(130).SetRange(0, 70)
+	b := btmp.New(130).SetRange(60, 70)
+
+	if got := a.HammingDistance(b); got != 120 {
+		t.Errorf("expected 120, got %d", got)
+	}
+	if got := a.HammingDistance(a); got != 0 {
+		t.Errorf("expected 0 for identical bitmaps, got %d", got)
+	}
+	if got := btmp.New(0).HammingDistance(btmp.New(0)); got != 0 {
+		t.Errorf("expected 0 for empty bitmaps, got %d", got)
+	}
+	if a.Count() != 70 || b.Count() != 70 {
+		t.Error("expected operands unchanged")
+	}
+}
+
+// TestBitmapOrAtXorAt validates Bitmap.OrAt() and Bitmap.XorAt() at offsets.
+func TestBitmapOrAtXorAt(t *testing.T) {
+	src := btmp.New(100)
+	for i := 0; i < 100; i += 3 {
+		src.SetBit(i)
+	}
+
+	for _, offset := range []int{0, 1, 37, 64, 128, 163, 200} {
+		base := btmp.New(300)
+		for i := 0; i < 300; i += 5 {
+			base.SetBit(i)
+		}
+
+		or := base.Clone().OrAt(offset, src)
+		xor := base.Clone().XorAt(offset, src)
+		for i := range 300 {
+			want := base.Test(i)
+			wantXor := want
+			if i >= offset && i < offset+src.Len() {
+				s := src.Test(i - offset)
+				want = want || s
+				wantXor = wantXor != s
+			}
+			if or.Test(i) != want {
+				t.Fatalf("OrAt(%d): bit %d expected %v", offset, i, want)
+			}
+			if xor.Test(i) != wantXor {
+				t.Fatalf("XorAt(%d): bit %d expected %v", offset, i, wantXor)
+			}
+		}
+	}
+
+	t.Run("empty other is a no-op", func(t *testing.T) {
+		b := btmp.New(10).SetBit(3).OrAt(10, btmp.New(0))
+		if b.Count() != 1 {
+			t.Errorf("expected count=1, got %d", b.Count())
+		}
+	})
+
+	t.Run("panics when window exceeds bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds window")
+			}
+		}()
+		btmp.New(100).OrAt(1, btmp.New(100))
+	})
+
+	t.Run("panics on negative offset", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative offset")
+			}
+		}()
+		btmp.New(100).XorAt(-1, btmp.New(10))
+	})
+
+	t.Run("panics on nil other", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil other")
+			}
+		}()
+		btmp.New(100).OrAt(0, nil)
+	})
+}
+
+// TestBitmapCombinedCounts validates AndCount, OrCount, and XorCount.
+func TestBitmapCombinedCounts(t *testing.T) {
+	for _, n := range []int{0, 1, 63, 64, 65, 130} {
+		a := btmp.New(uint(n))
+		b := btmp.New(uint(n))
+		for i := range n {
+			if i%2 == 0 {
+				a.SetBit(i)
+			}
+			if i%3 == 0 {
+				b.SetBit(i)
+			}
+		}
+
+		if got, want := a.AndCount(b), a.AndNew(b).Count(); got != want {
+			t.Errorf("n=%d: AndCount expected %d, got %d", n, want, got)
+		}
+		if got, want := a.OrCount(b), a.OrNew(b).Count(); got != want {
+			t.Errorf("n=%d: OrCount expected %d, got %d", n, want, got)
+		}
+		if got, want := a.XorCount(b), a.XorNew(b).Count(); got != want {
+			t.Errorf("n=%d: XorCount expected %d, got %d", n, want, got)
+		}
+	}
+
+	t.Run("does not modify operands", func(t *testing.T) {
+		a := btmp.New(100).SetRange(0, 50)
+		b := btmp.New(100).SetRange(25, 50)
+		a.AndCount(b)
+		a.OrCount(b)
+		a.XorCount(b)
+		if a.Count() != 50 || b.Count() != 50 {
+			t.Errorf("expected operands unchanged, got %d and %d", a.Count(), b.Count())
+		}
+	})
+
+	t.Run("panics on length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for length mismatch")
+			}
+		}()
+		btmp.New(10).OrCount(btmp.New(11))
+	})
+
+	t.Run("panics on nil other", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil other")
+			}
+		}()
+		btmp.New(10).AndCount(nil)
+	})
+}
+
+// TestBitmapEqual validates Bitmap.Equal() comparison.
+func TestBitmapEqual(t *testing.T) {
+	t.Run("equal content", func(t *testing.T) {
+		a := btmp.New(130).SetRange(10, 100)
+		b := btmp.New(130).SetRange(10, 100)
+		if !a.Equal(b) {
+			t.Error("expected bitmaps to be equal")
+		}
+	})
+
+	t.Run("different bits", func(t *testing.T) {
+		a := btmp.New(130).SetBit(129)
+		b := btmp.New(130)
+		if a.Equal(b) {
+			t.Error("expected bitmaps to differ")
+		}
+	})
+
+	t.Run("different lengths", func(t *testing.T) {
+		if btmp.New(10).Equal(btmp.New(11)) {
+			t.Error("expected bitmaps with different lengths to differ")
+		}
+	})
+
+	t.Run("ignores capacity", func(t *testing.T) {
+		a := btmp.New(70).SetBit(3)
+		b := btmp.New(70).Reserve(1000).SetBit(3)
+		if !a.Equal(b) {
+			t.Error("expected bitmaps to be equal regardless of capacity")
+		}
+	})
+
+	t.Run("empty bitmaps are equal", func(t *testing.T) {
+		if !btmp.New(0).Equal(btmp.New(0)) {
+			t.Error("expected empty bitmaps to be equal")
+		}
+	})
+
+	t.Run("panics on nil other", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil other")
+			}
+		}()
+		btmp.New(10).Equal(nil)
+	})
+}
+
+// TestBitmapHash validates Bitmap.Hash() determinism.
+func TestBitmapHash(t *testing.T) {
+	t.Run("equal bitmaps hash equally", func(t *testing.T) {
+		a := btmp.New(130).SetRange(10, 100)
+		b := btmp.New(300).SetRange(10, 100).Truncate(130)
+		if a.Hash() != b.Hash() {
+			t.Errorf("expected equal hashes, got %x and %x", a.Hash(), b.Hash())
+		}
+	})
+
+	t.Run("length is part of the hash", func(t *testing.T) {
+		if btmp.New(64).Hash() == btmp.New(128).Hash() {
+			t.Error("expected different hashes for different lengths")
+		}
+	})
+
+	t.Run("content is part of the hash", func(t *testing.T) {
+		if btmp.New(64).Hash() == btmp.New(64).SetBit(0).Hash() {
+			t.Error("expected different hashes for different content")
+		}
+	})
+}
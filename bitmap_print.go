@@ -1,53 +1,133 @@
 package btmp
 
-import "strings"
+import (
+	"fmt"
+	"io"
+	"math/bits"
+	"strings"
+	"unicode/utf8"
+)
 
-// printRangeFormat formats bits in [start, start+count) with format parameters.
+const (
+	// stringPreviewBits is the number of bits shown at each end by String()
+	// before the middle is elided.
+	stringPreviewBits = 32
+	// printBufSize bounds the buffer used by Fprint between writes.
+	printBufSize = 4096
+)
+
+// printRangeFormat formats bits in [start, start+count) according to opts.
+// All output goes through fprintRange, so it always matches Fprint.
 // Internal implementation - no validation.
-// base: 2 (binary) or 16 (hexadecimal)
-// grouped: insert separators between bit groups
-// groupSize: units per group (bits for base 2, hex digits for base 16)
-// sep: separator string
-func (b *Bitmap) printRangeFormat(start, count int, base int, grouped bool, groupSize int, sep string) string {
+func (b *Bitmap) printRangeFormat(start, count int, opts PrintOptions) string {
 	if count == 0 {
 		return ""
 	}
 
-	// For ranges <= 64 bits, single format call
-	if count <= WordBits {
-		bits := b.getBits(start, count)
-		return formatBits(bits, count, base, grouped, groupSize, sep)
+	var builder strings.Builder
+	builder.Grow(printSize(count, opts))
+
+	// strings.Builder never returns an error
+	_, _ = b.fprintRange(&builder, start, count, opts)
+	return builder.String()
+}
+
+// printSize estimates the formatted size in bytes of count bits under opts,
+// counting one byte per glyph.
+func printSize(count int, opts PrintOptions) int {
+	size := count
+	switch opts.Base {
+	case 8:
+		size = (count + 2) / 3
+	case 16:
+		size = (count + 3) / 4
 	}
+	if opts.Grouped {
+		size += (size / opts.GroupSize) * len(opts.Sep)
+	}
+	return size
+}
 
-	// For ranges > 64 bits:
-	// 1. Build ungrouped string from chunks
-	// 2. Apply grouping to complete string
+// fprintRange writes bits in [start, start+count) to w according to opts.
+// Each chunk is formatted without grouping and separators are inserted while
+// copying into a buffer of at most about printBufSize bytes, so memory stays
+// bounded regardless of count.
+// Returns the number of bytes written and the first write error.
+// Internal implementation - no validation.
+func (b *Bitmap) fprintRange(w io.Writer, start, count int, opts PrintOptions) (int, error) {
+	chunkBits := printChunkBits(opts.Base)
+	chunkOpts := opts
+	chunkOpts.Grouped = false
 
-	var builder strings.Builder
-	estimatedSize := count
-	if base == 16 {
-		estimatedSize = (count + 3) / 4
+	buf := make([]byte, 0, min(printSize(count, opts), printBufSize))
+	total := 0
+	flush := func() error {
+		n, err := w.Write(buf)
+		total += n
+		buf = buf[:0]
+		return err
 	}
-	builder.Grow(estimatedSize)
 
-	remaining := count
-	pos := start
+	units := 0
+	for pos, end := start, start+count; pos < end; pos += chunkBits {
+		n := min(end-pos, chunkBits)
+		chunk := formatBits(b.printChunk(pos, n, opts.LSBFirst), n, chunkOpts)
+		for _, c := range chunk {
+			if opts.Grouped && units > 0 && units%opts.GroupSize == 0 {
+				buf = append(buf, opts.Sep...)
+			}
+			buf = utf8.AppendRune(buf, c)
+			units++
+		}
+		if len(buf) >= printBufSize {
+			if err := flush(); err != nil {
+				return total, err
+			}
+		}
+	}
+	if len(buf) > 0 {
+		if err := flush(); err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
 
-	for remaining > 0 {
-		chunkSize := min(remaining, WordBits)
-		bits := b.getBits(pos, chunkSize)
-		// Format without grouping
-		builder.WriteString(formatBits(bits, chunkSize, base, false, 0, ""))
+// printChunkBits returns the number of bits formatted per chunk for base:
+// 64, or 63 for octal so no digit spans two chunks. Every range is split at
+// these boundaries, so a range's digit layout does not depend on its length.
+func printChunkBits(base int) int {
+	if base == 8 {
+		return 63
+	}
+	return WordBits
+}
 
-		remaining -= chunkSize
-		pos += chunkSize
+// printChunk reads n bits at pos right-aligned, reversed within the n bits if
+// lsbFirst is set so that bit pos becomes the most significant.
+// Internal implementation - no validation.
+func (b *Bitmap) printChunk(pos, n int, lsbFirst bool) uint64 {
+	v := b.getBits(pos, n)
+	if lsbFirst {
+		v = bits.Reverse64(v) >> uint(WordBits-n)
 	}
+	return v
+}
 
-	ungrouped := builder.String()
+// string formats a summary with a bounded binary preview.
+// Internal implementation - no validation.
+func (b *Bitmap) string() string {
+	header := fmt.Sprintf("Bitmap(len=%d, count=%d)", b.lenBits, b.count())
+	if b.lenBits == 0 {
+		return header
+	}
 
-	if grouped {
-		return applyGrouping(ungrouped, groupSize, sep)
+	if b.lenBits <= 2*stringPreviewBits {
+		return header + " " + b.printRangeFormat(0, b.lenBits, DefaultPrintOptions())
 	}
 
-	return ungrouped
+	opts := DefaultPrintOptions()
+	head := b.printRangeFormat(0, stringPreviewBits, opts)
+	tail := b.printRangeFormat(b.lenBits-stringPreviewBits, stringPreviewBits, opts)
+	return header + " " + head + "..." + tail
 }
@@ -0,0 +1,332 @@
+package btmp_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestBitmapString validates Bitmap.String() debug output.
+func TestBitmapString(t *testing.T) {
+	t.Run("empty bitmap", func(t *testing.T) {
+		got := btmp.New(0).String()
+		if got != "Bitmap(len=0, count=0)" {
+			t.Errorf("unexpected output: %q", got)
+		}
+	})
+
+	t.Run("small bitmap shows all bits", func(t *testing.T) {
+		b := btmp.New(8).SetBit(1).SetBit(7)
+		got := fmt.Sprintf("%v", b)
+		want := "Bitmap(len=8, count=2) " + b.Print()
+		if got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("large bitmap is truncated", func(t *testing.T) {
+		b := btmp.New(1_000_000).SetBit(0).SetBit(999_999)
+		got := b.String()
+		want := "Bitmap(len=1000000, count=2) " + b.PrintRange(0, 32) + "..." + b.PrintRange(999_968, 32)
+		if got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if _, preview, _ := strings.Cut(got, ") "); strings.Count(preview, "1") != 2 {
+			t.Errorf("expected both set bits in preview, got %q", got)
+		}
+	})
+}
+
+// TestBitmapPrintWith validates Bitmap.PrintWith() and PrintRangeWith().
+func TestBitmapPrintWith(t *testing.T) {
+	b := btmp.New(100).SetBit(0).SetBit(3).SetRange(64, 6).SetBit(99)
+
+	t.Run("defaults match Print", func(t *testing.T) {
+		if got, want := b.PrintWith(btmp.DefaultPrintOptions()), b.Print(); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("custom glyphs", func(t *testing.T) {
+		opts := btmp.DefaultPrintOptions()
+		opts.Set, opts.Clear = '█', '░'
+		want := strings.NewReplacer("1", "█", "0", "░").Replace(b.Print())
+		if got := b.PrintWith(opts); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("zero glyphs use defaults", func(t *testing.T) {
+		if got, want := b.PrintWith(btmp.PrintOptions{Base: 2}), b.Print(); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		opts := btmp.PrintOptions{Base: 2, Set: '#'}
+		want := strings.ReplaceAll(b.PrintRange(0, 8), "1", "#")
+		if got := b.PrintRangeWith(0, 8, opts); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("grouping counts glyphs", func(t *testing.T) {
+		opts := btmp.DefaultPrintOptions()
+		opts.Set, opts.Clear = '█', '░'
+		opts.Grouped, opts.GroupSize, opts.Sep = true, 4, "_"
+		want := strings.NewReplacer("1", "█", "0", "░").Replace(b.PrintRangeFormat(60, 12, 2, true, 4, "_"))
+		if got := b.PrintRangeWith(60, 12, opts); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("glyphs ignored in base 16", func(t *testing.T) {
+		opts := btmp.DefaultPrintOptions()
+		opts.Base = 16
+		opts.Set, opts.Clear = 'x', 'o'
+		if got, want := b.PrintRangeWith(0, 70, opts), b.PrintRangeFormat(0, 70, 16, false, 0, ""); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("panics on invalid base", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for zero-value options")
+			}
+		}()
+		b.PrintWith(btmp.PrintOptions{})
+	})
+}
+
+// TestBitmapPrintOctal validates base 8 output of PrintRangeFormat().
+func TestBitmapPrintOctal(t *testing.T) {
+	t.Run("single word", func(t *testing.T) {
+		b := btmp.New(9).SetBits(0, 9, 0o755)
+		if got := b.PrintFormat(8, false, 0, ""); got != "755" {
+			t.Errorf("expected %q, got %q", "755", got)
+		}
+	})
+
+	t.Run("incomplete final digit", func(t *testing.T) {
+		b := btmp.New(8).SetBits(0, 8, 0xFF)
+		if got := b.PrintFormat(8, false, 0, ""); got != "377" {
+			t.Errorf("expected %q, got %q", "377", got)
+		}
+	})
+
+	t.Run("grouped digits", func(t *testing.T) {
+		b := btmp.New(12).SetBits(0, 12, 0o1234)
+		if got := b.PrintFormat(8, true, 2, " "); got != "12 34" {
+			t.Errorf("expected %q, got %q", "12 34", got)
+		}
+	})
+
+	t.Run("multi-word ranges split on digit boundaries", func(t *testing.T) {
+		b := btmp.New(126).SetAll()
+		want := strings.Repeat("7", 42)
+		if got := b.PrintFormat(8, false, 0, ""); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("digit layout independent of length", func(t *testing.T) {
+		tests := []struct {
+			n    uint
+			hi   int
+			want string
+		}{
+			{63, 62, "400000000000000000001"},
+			{64, 63, "0000000000000000000011"},
+			{65, 63, "0000000000000000000011"},
+		}
+		for _, tt := range tests {
+			b := btmp.New(tt.n).SetBit(0).SetBit(tt.hi)
+			if got := b.PrintFormat(8, false, 0, ""); got != tt.want {
+				t.Errorf("n=%d: expected %q, got %q", tt.n, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("panics on unsupported base", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			err, ok := r.(*btmp.ValidationError)
+			if !ok || !strings.Contains(err.Error(), "2, 8, or 16") {
+				t.Errorf("expected ValidationError listing base 8, got %v", r)
+			}
+		}()
+		btmp.New(8).PrintFormat(10, false, 0, "")
+	})
+}
+
+// TestBitmapPrintLowercaseHex validates the Lowercase print option.
+func TestBitmapPrintLowercaseHex(t *testing.T) {
+	b := btmp.New(96).SetBits(0, 32, 0xDEADBEEF).SetBits(64, 32, 0xABCDEF01)
+	opts := btmp.DefaultPrintOptions()
+	opts.Base = 16
+
+	t.Run("default stays uppercase", func(t *testing.T) {
+		if got := b.PrintRangeWith(0, 32, opts); got != "DEADBEEF" {
+			t.Errorf("expected %q, got %q", "DEADBEEF", got)
+		}
+	})
+
+	t.Run("lowercase", func(t *testing.T) {
+		opts := opts
+		opts.Lowercase = true
+		if got := b.PrintRangeWith(0, 32, opts); got != "deadbeef" {
+			t.Errorf("expected %q, got %q", "deadbeef", got)
+		}
+	})
+
+	t.Run("grouping and padding unchanged", func(t *testing.T) {
+		upper := opts
+		upper.Grouped, upper.GroupSize, upper.Sep = true, 3, "_"
+		lower := upper
+		lower.Lowercase = true
+		if got, want := b.PrintWith(lower), strings.ToLower(b.PrintWith(upper)); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := b.PrintRangeWith(0, 6, lower); got != "2f" {
+			t.Errorf("expected %q, got %q", "2f", got)
+		}
+	})
+}
+
+// TestBitmapPrintLSBFirst validates the LSBFirst print option.
+func TestBitmapPrintLSBFirst(t *testing.T) {
+	b := btmp.New(8).SetBit(0).SetBit(1).SetBit(5)
+	opts := btmp.DefaultPrintOptions()
+
+	t.Run("default ordering preserved", func(t *testing.T) {
+		if got := b.PrintWith(opts); got != "00100011" {
+			t.Errorf("expected %q, got %q", "00100011", got)
+		}
+	})
+
+	t.Run("reverses binary", func(t *testing.T) {
+		opts := opts
+		opts.LSBFirst = true
+		if got := b.PrintWith(opts); got != "11000100" {
+			t.Errorf("expected %q, got %q", "11000100", got)
+		}
+	})
+
+	t.Run("grouping unchanged", func(t *testing.T) {
+		opts := opts
+		opts.LSBFirst = true
+		opts.Grouped, opts.GroupSize, opts.Sep = true, 3, "_"
+		if got := b.PrintWith(opts); got != "110_001_00" {
+			t.Errorf("expected %q, got %q", "110_001_00", got)
+		}
+	})
+
+	t.Run("hex digits formed after reversal", func(t *testing.T) {
+		opts := opts
+		opts.Base = 16
+		opts.LSBFirst = true
+		if got := b.PrintWith(opts); got != "C4" {
+			t.Errorf("expected %q, got %q", "C4", got)
+		}
+	})
+
+	t.Run("multi-word ranges read in index order", func(t *testing.T) {
+		big := btmp.New(130).SetBit(0).SetBit(64).SetBit(129)
+		opts := opts
+		opts.LSBFirst = true
+		got := big.PrintWith(opts)
+		for _, i := range []int{0, 64, 129} {
+			if got[i] != '1' {
+				t.Errorf("expected bit %d at position %d, got %q", i, i, got)
+			}
+		}
+		if strings.Count(got, "1") != 3 {
+			t.Errorf("expected 3 set bits, got %q", got)
+		}
+	})
+}
+
+// TestBitmapFprint validates Bitmap.Fprint() streaming output.
+func TestBitmapFprint(t *testing.T) {
+	b := btmp.New(20_000)
+	for i := 0; i < b.Len(); i += 7 {
+		b.SetBit(i)
+	}
+
+	t.Run("matches PrintRangeFormat", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			start, count int
+			base         int
+			grouped      bool
+			groupSize    int
+			sep          string
+		}{
+			{"binary", 0, b.Len(), 2, false, 0, ""},
+			{"binary grouped", 3, 10_000, 2, true, 8, "_"},
+			{"octal grouped", 5, 1_000, 8, true, 3, " "},
+			{"hex grouped", 0, b.Len(), 16, true, 4, " "},
+			{"short range", 10, 20, 2, true, 4, "_"},
+			{"empty range", 10, 0, 2, false, 0, ""},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				n, err := b.Fprint(&buf, tt.start, tt.count, tt.base, tt.grouped, tt.groupSize, tt.sep)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				want := b.PrintRangeFormat(tt.start, tt.count, tt.base, tt.grouped, tt.groupSize, tt.sep)
+				if buf.String() != want {
+					t.Errorf("output differs from PrintRangeFormat")
+				}
+				if n != len(want) {
+					t.Errorf("expected n=%d, got %d", len(want), n)
+				}
+			})
+		}
+	})
+
+	t.Run("matches PrintRangeFormat around chunk sizes", func(t *testing.T) {
+		x := btmp.New(200)
+		for _, i := range []int{0, 1, 62, 63, 64, 65, 127, 128} {
+			x.SetBit(i)
+		}
+		for _, base := range []int{2, 8, 16} {
+			for count := 62; count <= 66; count++ {
+				for _, grouped := range []bool{false, true} {
+					var buf bytes.Buffer
+					if _, err := x.Fprint(&buf, 0, count, base, grouped, 3, "_"); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					want := x.PrintRangeFormat(0, count, base, grouped, 3, "_")
+					if buf.String() != want {
+						t.Errorf("base=%d count=%d grouped=%v: expected %q, got %q", base, count, grouped, want, buf.String())
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("propagates write errors", func(t *testing.T) {
+		n, err := b.Fprint(&limitedWriter{n: 5000}, 0, b.Len(), 2, false, 0, "")
+		if !errors.Is(err, io.ErrShortWrite) {
+			t.Errorf("expected io.ErrShortWrite, got %v", err)
+		}
+		if n != 5000 {
+			t.Errorf("expected n=5000, got %d", n)
+		}
+	})
+
+	t.Run("panics on invalid range", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-range count")
+			}
+		}()
+		b.Fprint(io.Discard, 0, b.Len()+1, 2, false, 0, "")
+	})
+}
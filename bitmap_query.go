@@ -1,6 +1,9 @@
 package btmp
 
-import "math/bits"
+import (
+	"iter"
+	"math/bits"
+)
 
 // test reports whether bit pos is set.
 // Internal implementation - no validation.
@@ -121,6 +124,86 @@ func (b *Bitmap) nextBitInRange(pos, count int, target bool) int {
 	return -1
 }
 
+// prevZero returns the position of the nearest zero bit at or before pos.
+// Returns -1 if no zero bit exists in [0, pos].
+// Internal implementation - no validation.
+func (b *Bitmap) prevZero(pos int) int {
+	return b.prevBit(pos, false)
+}
+
+// prevOne returns the position of the nearest set bit at or before pos.
+// Returns -1 if no set bit exists in [0, pos].
+// Internal implementation - no validation.
+func (b *Bitmap) prevOne(pos int) int {
+	return b.prevBit(pos, true)
+}
+
+// prevBit returns the position of the nearest bit matching target value in [0, pos].
+// If target is true, searches for set bits (1). If false, searches for zero bits (0).
+// Returns -1 if no matching bit exists. Bits beyond pos are masked off, so
+// positions >= Len() are never returned for pos < Len().
+// Internal implementation - no validation.
+func (b *Bitmap) prevBit(pos int, target bool) int {
+	w := wordIdx(pos)
+	mask := MaskUpto(uint(bitOffset(pos) + 1))
+
+	for ; w >= 0; w-- {
+		var matched uint64
+		if target {
+			matched = b.words[w] & mask
+		} else {
+			matched = (^b.words[w]) & mask
+		}
+
+		if matched != 0 {
+			return w*WordBits + IndexMask - bits.LeadingZeros64(matched)
+		}
+		mask = WordMask
+	}
+
+	return -1
+}
+
+// firstSet returns the lowest set bit position, or -1 if none.
+// Internal implementation - no validation.
+func (b *Bitmap) firstSet() int {
+	if b.lenBits == 0 {
+		return -1
+	}
+	return b.nextOne(0)
+}
+
+// lastSet returns the highest set bit position, or -1 if none.
+// Scans from the top word down; the tail is masked by starting at Len()-1.
+// Internal implementation - no validation.
+func (b *Bitmap) lastSet() int {
+	if b.lenBits == 0 {
+		return -1
+	}
+	return b.prevOne(b.lenBits - 1)
+}
+
+// findRun returns the first index >= pos where count consecutive bits match
+// target, or -1 if no such run fits before Len().
+// Uses nextBitInRange to reach each candidate and countBitsFromInRange to
+// measure it, so short runs are skipped a word at a time.
+// Internal implementation - no validation, requires count > 0.
+func (b *Bitmap) findRun(pos, count int, target bool) int {
+	for pos+count <= b.lenBits {
+		p := b.nextBitInRange(pos, b.lenBits-pos, target)
+		if p == -1 || p+count > b.lenBits {
+			return -1
+		}
+		n := b.countBitsFromInRange(p, count, target)
+		if n == count {
+			return p
+		}
+		// Bit p+n does not match; resume after it
+		pos = p + n + 1
+	}
+	return -1
+}
+
 // countZerosFrom counts consecutive zero bits starting at pos.
 // Returns 0 if bit at pos is set.
 // Stops at first set bit or end of bitmap.
@@ -205,3 +288,147 @@ func (b *Bitmap) countBitsFromInRange(pos, count int, target bool) int {
 
 	return bitCount
 }
+
+// positions returns the indexes of all set bits in [0, Len()) in ascending order.
+// Internal implementation - no validation.
+func (b *Bitmap) positions() []int {
+	out := make([]int, 0, b.count())
+	if b.lenBits == 0 {
+		return out
+	}
+
+	for i := range b.lastWordIdx + 1 {
+		word := b.words[i]
+		if i == b.lastWordIdx {
+			word &= b.tailMask
+		}
+		for word != 0 {
+			tz := bits.TrailingZeros64(word)
+			out = append(out, i*WordBits+tz)
+			word &= word - 1 // clear lowest set bit
+		}
+	}
+	return out
+}
+
+// rank returns the number of set bits in [0, pos).
+// Internal implementation - no validation.
+func (b *Bitmap) rank(pos int) int {
+	w := wordIdx(pos)
+	sum := 0
+	for i := range w {
+		sum += bits.OnesCount64(b.words[i])
+	}
+	if off := bitOffset(pos); off != 0 {
+		sum += bits.OnesCount64(b.words[w] & MaskUpto(uint(off)))
+	}
+	return sum
+}
+
+// selectBit returns the position of the k-th set bit (0-based).
+// Returns -1 if fewer than k+1 bits are set.
+// Internal implementation - no validation.
+func (b *Bitmap) selectBit(k int) int {
+	if b.lenBits == 0 {
+		return -1
+	}
+
+	for i := range b.lastWordIdx + 1 {
+		word := b.words[i]
+		if i == b.lastWordIdx {
+			word &= b.tailMask
+		}
+
+		// Skip whole words that cannot contain the k-th bit
+		n := bits.OnesCount64(word)
+		if k >= n {
+			k -= n
+			continue
+		}
+
+		// Drop the k lowest set bits, then the answer is the lowest remaining
+		for range k {
+			word &= word - 1
+		}
+		return i*WordBits + bits.TrailingZeros64(word)
+	}
+
+	return -1
+}
+
+// bitsSeq returns an iterator over positions in [0, Len()) whose bit matches
+// target, in ascending order. Zero bits are found by inverting each word;
+// the last word is masked so positions >= Len() are never yielded.
+// Internal implementation - no validation.
+func (b *Bitmap) bitsSeq(target bool) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if b.lenBits == 0 {
+			return
+		}
+
+		for i := range b.lastWordIdx + 1 {
+			word := b.words[i]
+			if !target {
+				word = ^word
+			}
+			if i == b.lastWordIdx {
+				word &= b.tailMask
+			}
+			for word != 0 {
+				if !yield(i*WordBits + bits.TrailingZeros64(word)) {
+					return
+				}
+				word &= word - 1 // clear lowest set bit
+			}
+		}
+	}
+}
+
+// countRuns returns the number of maximal runs of bits matching target.
+// Each word is XORed with itself shifted up by one (carrying the previous
+// word's top bit) to find transitions; transitions into a matching bit mark
+// run starts. The last word is masked so the boundary at Len() is respected.
+// Internal implementation - no validation.
+func (b *Bitmap) countRuns(target bool) int {
+	if b.lenBits == 0 {
+		return 0
+	}
+
+	runs := 0
+	var carry uint64 // matching state of the bit preceding the current word
+	for i := range b.lastWordIdx + 1 {
+		x := b.words[i]
+		if !target {
+			x = ^x
+		}
+		if i == b.lastWordIdx {
+			x &= b.tailMask
+		}
+
+		transitions := x ^ (x<<1 | carry)
+		runs += bits.OnesCount64(transitions & x)
+		carry = x >> IndexMask
+	}
+	return runs
+}
+
+// runs returns an iterator over maximal runs of set bits as (start, length).
+// Each run is found with nextOne and measured with countOnesFrom, which skip
+// whole words; runs are terminated at Len().
+// Internal implementation - no validation.
+func (b *Bitmap) runs() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		pos := 0
+		for pos < b.lenBits {
+			start := b.nextOne(pos)
+			if start == -1 {
+				return
+			}
+			n := b.countOnesFrom(start)
+			if !yield(start, n) {
+				return
+			}
+			pos = start + n
+		}
+	}
+}
@@ -417,3 +417,523 @@ func TestBitmapCountOnesFromInRange(t *testing.T) {
 		b.CountOnesFromInRange(95, 10)
 	})
 }
+
+// TestBitmapPositions validates Bitmap.Positions() query operation.
+func TestBitmapPositions(t *testing.T) {
+	t.Run("returns set positions in ascending order", func(t *testing.T) {
+		b := btmp.New(200)
+		want := []int{0, 5, 63, 64, 127, 128, 199}
+		for _, pos := range want {
+			b.SetBit(pos)
+		}
+
+		got := b.Positions()
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	})
+
+	t.Run("returns empty non-nil slice", func(t *testing.T) {
+		for _, n := range []uint{0, 100} {
+			got := btmp.New(n).Positions()
+			if got == nil || len(got) != 0 {
+				t.Errorf("New(%d): expected empty non-nil slice, got %v", n, got)
+			}
+		}
+	})
+
+	t.Run("no phantom positions beyond Len", func(t *testing.T) {
+		b := btmp.New(70).SetAll()
+		got := b.Positions()
+		if len(got) != 70 || got[len(got)-1] != 69 {
+			t.Errorf("expected 70 positions ending at 69, got %d ending at %d", len(got), got[len(got)-1])
+		}
+	})
+}
+
+// TestBitmapPrevOne validates Bitmap.PrevOne() reverse scan.
+func TestBitmapPrevOne(t *testing.T) {
+	b := btmp.New(200).SetBit(3).SetBit(64).SetBit(130)
+
+	tests := []struct {
+		pos, want int
+	}{
+		{0, -1},
+		{2, -1},
+		{3, 3},
+		{63, 3},
+		{64, 64},
+		{129, 64},
+		{130, 130},
+		{199, 130},
+	}
+	for _, tt := range tests {
+		if got := b.PrevOne(tt.pos); got != tt.want {
+			t.Errorf("PrevOne(%d): expected %d, got %d", tt.pos, tt.want, got)
+		}
+	}
+
+	t.Run("panics on position >= Len", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for position >= Len")
+			}
+		}()
+		b.PrevOne(200)
+	})
+}
+
+// TestBitmapPrevZero validates Bitmap.PrevZero() reverse scan.
+func TestBitmapPrevZero(t *testing.T) {
+	b := btmp.New(100).SetAll().ClearBit(5).ClearBit(70)
+
+	tests := []struct {
+		pos, want int
+	}{
+		{0, -1},
+		{4, -1},
+		{5, 5},
+		{69, 5},
+		{70, 70},
+		{99, 70},
+	}
+	for _, tt := range tests {
+		if got := b.PrevZero(tt.pos); got != tt.want {
+			t.Errorf("PrevZero(%d): expected %d, got %d", tt.pos, tt.want, got)
+		}
+	}
+
+	t.Run("never reports zeros beyond Len", func(t *testing.T) {
+		full := btmp.New(70).SetAll()
+		if got := full.PrevZero(69); got != -1 {
+			t.Errorf("expected -1, got %d", got)
+		}
+	})
+}
+
+// TestBitmapRank validates Bitmap.Rank() query operation.
+func TestBitmapRank(t *testing.T) {
+	b := btmp.New(200).SetBit(0).SetBit(63).SetBit(64).SetBit(150).SetBit(199)
+
+	tests := []struct {
+		pos, want int
+	}{
+		{0, 0},
+		{1, 1},
+		{63, 1},
+		{64, 2},
+		{65, 3},
+		{128, 3},
+		{151, 4},
+		{199, 4},
+		{200, 5},
+	}
+	for _, tt := range tests {
+		if got := b.Rank(tt.pos); got != tt.want {
+			t.Errorf("Rank(%d): expected %d, got %d", tt.pos, tt.want, got)
+		}
+	}
+
+	t.Run("Rank(Len) equals Count", func(t *testing.T) {
+		full := btmp.New(130).SetAll()
+		if full.Rank(130) != full.Count() {
+			t.Errorf("expected %d, got %d", full.Count(), full.Rank(130))
+		}
+		if btmp.New(0).Rank(0) != 0 {
+			t.Error("expected 0 for empty bitmap")
+		}
+	})
+
+	t.Run("panics on pos > Len", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for pos > Len")
+			}
+		}()
+		b.Rank(201)
+	})
+}
+
+// TestBitmapSelect validates Bitmap.Select() query operation.
+func TestBitmapSelect(t *testing.T) {
+	b := btmp.New(200).SetBit(0).SetBit(63).SetBit(64).SetBit(150).SetBit(199)
+
+	want := []int{0, 63, 64, 150, 199}
+	for k, pos := range want {
+		if got := b.Select(k); got != pos {
+			t.Errorf("Select(%d): expected %d, got %d", k, pos, got)
+		}
+		if got := b.Rank(b.Select(k)); got != k {
+			t.Errorf("Rank(Select(%d)): expected %d, got %d", k, k, got)
+		}
+	}
+
+	t.Run("returns -1 beyond Count", func(t *testing.T) {
+		if got := b.Select(5); got != -1 {
+			t.Errorf("expected -1, got %d", got)
+		}
+		if got := btmp.New(0).Select(0); got != -1 {
+			t.Errorf("expected -1 for empty bitmap, got %d", got)
+		}
+	})
+
+	t.Run("dense word", func(t *testing.T) {
+		full := btmp.New(100).SetAll()
+		for _, k := range []int{0, 31, 63, 64, 99} {
+			if got := full.Select(k); got != k {
+				t.Errorf("Select(%d): expected %d, got %d", k, k, got)
+			}
+		}
+	})
+
+	t.Run("panics on negative k", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative k")
+			}
+		}()
+		b.Select(-1)
+	})
+}
+
+// TestBitmapFirstLastSet validates Bitmap.FirstSet() and Bitmap.LastSet().
+func TestBitmapFirstLastSet(t *testing.T) {
+	t.Run("returns min and max set positions", func(t *testing.T) {
+		b := btmp.New(300).SetBit(70).SetBit(128).SetBit(255)
+		if got := b.FirstSet(); got != 70 {
+			t.Errorf("FirstSet: expected 70, got %d", got)
+		}
+		if got := b.LastSet(); got != 255 {
+			t.Errorf("LastSet: expected 255, got %d", got)
+		}
+	})
+
+	t.Run("single bit", func(t *testing.T) {
+		b := btmp.New(64).SetBit(63)
+		if b.FirstSet() != 63 || b.LastSet() != 63 {
+			t.Errorf("expected 63/63, got %d/%d", b.FirstSet(), b.LastSet())
+		}
+	})
+
+	t.Run("returns -1 when empty", func(t *testing.T) {
+		for _, n := range []uint{0, 100} {
+			b := btmp.New(n)
+			if b.FirstSet() != -1 || b.LastSet() != -1 {
+				t.Errorf("New(%d): expected -1/-1, got %d/%d", n, b.FirstSet(), b.LastSet())
+			}
+		}
+	})
+
+	t.Run("last set in partial tail word", func(t *testing.T) {
+		b := btmp.New(70).SetAll()
+		if got := b.LastSet(); got != 69 {
+			t.Errorf("expected 69, got %d", got)
+		}
+	})
+}
+
+// TestBitmapOneBits validates Bitmap.OneBits() iteration.
+func TestBitmapOneBits(t *testing.T) {
+	t.Run("yields set positions in order", func(t *testing.T) {
+		b := btmp.New(200).SetBit(1).SetBit(64).SetBit(65).SetBit(199)
+		var got []int
+		for i := range b.OneBits() {
+			got = append(got, i)
+		}
+		want := b.Positions()
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	})
+
+	t.Run("honors early break", func(t *testing.T) {
+		b := btmp.New(100).SetAll()
+		n := 0
+		for range b.OneBits() {
+			n++
+			if n == 3 {
+				break
+			}
+		}
+		if n != 3 {
+			t.Errorf("expected 3 iterations, got %d", n)
+		}
+	})
+
+	t.Run("yields nothing for empty bitmap", func(t *testing.T) {
+		for range btmp.New(0).OneBits() {
+			t.Fatal("expected no positions")
+		}
+	})
+
+	t.Run("never yields beyond Len", func(t *testing.T) {
+		last := -1
+		for i := range btmp.New(70).SetAll().OneBits() {
+			last = i
+		}
+		if last != 69 {
+			t.Errorf("expected last=69, got %d", last)
+		}
+	})
+}
+
+// TestBitmapZeroBits validates Bitmap.ZeroBits() iteration.
+func TestBitmapZeroBits(t *testing.T) {
+	t.Run("yields clear positions in order", func(t *testing.T) {
+		b := btmp.New(70).SetAll().ClearBit(0).ClearBit(64).ClearBit(69)
+		var got []int
+		for i := range b.ZeroBits() {
+			got = append(got, i)
+		}
+		if len(got) != 3 || got[0] != 0 || got[1] != 64 || got[2] != 69 {
+			t.Errorf("expected [0 64 69], got %v", got)
+		}
+	})
+
+	t.Run("never yields beyond Len", func(t *testing.T) {
+		n := 0
+		for i := range btmp.New(70).ZeroBits() {
+			if i >= 70 {
+				t.Fatalf("unexpected position %d", i)
+			}
+			n++
+		}
+		if n != 70 {
+			t.Errorf("expected 70 positions, got %d", n)
+		}
+	})
+
+	t.Run("yields nothing when fully set", func(t *testing.T) {
+		for range btmp.New(130).SetAll().ZeroBits() {
+			t.Fatal("expected no positions")
+		}
+	})
+
+	t.Run("honors early break", func(t *testing.T) {
+		n := 0
+		for range btmp.New(100).ZeroBits() {
+			n++
+			break
+		}
+		if n != 1 {
+			t.Errorf("expected 1 iteration, got %d", n)
+		}
+	})
+}
+
+// TestBitmapRuns validates Bitmap.Runs() iteration.
+func TestBitmapRuns(t *testing.T) {
+	collect := func(b *btmp.Bitmap) [][2]int {
+		var out [][2]int
+		for start, n := range b.Runs() {
+			out = append(out, [2]int{start, n})
+		}
+		return out
+	}
+
+	t.Run("yields maximal runs", func(t *testing.T) {
+		b := btmp.New(200).SetBit(0).SetRange(60, 70).SetRange(140, 3).SetBit(199)
+		got := collect(b)
+		want := [][2]int{{0, 1}, {60, 70}, {140, 3}, {199, 1}}
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	})
+
+	t.Run("run terminates at partial tail", func(t *testing.T) {
+		got := collect(btmp.New(70).SetRange(30, 40))
+		if len(got) != 1 || got[0] != [2]int{30, 40} {
+			t.Errorf("expected [[30 40]], got %v", got)
+		}
+	})
+
+	t.Run("yields nothing for all-zero bitmap", func(t *testing.T) {
+		if got := collect(btmp.New(100)); len(got) != 0 {
+			t.Errorf("expected no runs, got %v", got)
+		}
+		if got := collect(btmp.New(0)); len(got) != 0 {
+			t.Errorf("expected no runs, got %v", got)
+		}
+	})
+
+	t.Run("honors early break", func(t *testing.T) {
+		b := btmp.New(100).SetBit(1).SetBit(3).SetBit(5)
+		n := 0
+		for range b.Runs() {
+			n++
+			break
+		}
+		if n != 1 {
+			t.Errorf("expected 1 iteration, got %d", n)
+		}
+	})
+}
+
+// TestBitmapFindFirstRun validates Bitmap.FindFirstRun() gap search.
+func TestBitmapFindFirstRun(t *testing.T) {
+	b := btmp.New(300)
+	b.SetRange(0, 10).SetBit(15).SetRange(50, 20).SetBit(200)
+
+	tests := []struct {
+		count, want int
+	}{
+		{1, 10},
+		{5, 10},
+		{6, 16},
+		{34, 16},
+		{35, 70},
+		{130, 70},
+		{131, -1},
+	}
+	for _, tt := range tests {
+		if got := b.FindFirstRun(tt.count); got != tt.want {
+			t.Errorf("FindFirstRun(%d): expected %d, got %d", tt.count, tt.want, got)
+		}
+	}
+
+	t.Run("run at end of bitmap", func(t *testing.T) {
+		b := btmp.New(300).SetRange(0, 200).SetBit(240)
+		if got := b.FindFirstRun(59); got != 241 {
+			t.Errorf("expected 241, got %d", got)
+		}
+		if got := b.FindFirstRun(60); got != -1 {
+			t.Errorf("expected -1, got %d", got)
+		}
+	})
+
+	t.Run("run straddling word boundary", func(t *testing.T) {
+		b := btmp.New(200).SetRange(0, 60).SetRange(70, 130)
+		if got := b.FindFirstRun(10); got != 60 {
+			t.Errorf("expected 60, got %d", got)
+		}
+	})
+
+	t.Run("returns -1 when full or empty", func(t *testing.T) {
+		if got := btmp.New(100).SetAll().FindFirstRun(1); got != -1 {
+			t.Errorf("expected -1, got %d", got)
+		}
+		if got := btmp.New(0).FindFirstRun(1); got != -1 {
+			t.Errorf("expected -1, got %d", got)
+		}
+	})
+
+	t.Run("panics on non-positive count", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for count=0")
+			}
+		}()
+		b.FindFirstRun(0)
+	})
+}
+
+// TestBitmapNextRun validates Bitmap.NextRun() run search.
+func TestBitmapNextRun(t *testing.T) {
+	b := btmp.New(300).SetRange(10, 5).SetRange(60, 80).SetBit(250)
+
+	tests := []struct {
+		start, count int
+		value        bool
+		want         int
+	}{
+		{0, 5, true, 10},
+		{0, 6, true, 60},
+		{11, 4, true, 11},
+		{12, 4, true, 60},
+		{100, 40, true, 100},
+		{100, 41, true, -1},
+		{0, 10, false, 0},
+		{0, 11, false, 15},
+		{60, 1, false, 140},
+		{251, 49, false, 251},
+		{251, 50, false, -1},
+		{299, 1, false, 299},
+	}
+	for _, tt := range tests {
+		if got := b.NextRun(tt.start, tt.count, tt.value); got != tt.want {
+			t.Errorf("NextRun(%d, %d, %v): expected %d, got %d", tt.start, tt.count, tt.value, tt.want, got)
+		}
+	}
+
+	t.Run("panics on start >= Len", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for start >= Len")
+			}
+		}()
+		b.NextRun(300, 1, true)
+	})
+
+	t.Run("panics on non-positive count", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for count=0")
+			}
+		}()
+		b.NextRun(0, 0, true)
+	})
+}
+
+// TestBitmapCountRuns validates Bitmap.CountRuns() fragmentation metric.
+func TestBitmapCountRuns(t *testing.T) {
+	tests := []struct {
+		name        string
+		b           *btmp.Bitmap
+		ones, zeros int
+	}{
+		{"empty", btmp.New(0), 0, 0},
+		{"all clear", btmp.New(100), 0, 1},
+		{"all set", btmp.New(100).SetAll(), 1, 0},
+		{"all set full words", btmp.New(128).SetAll(), 1, 0},
+		{"run across word boundary", btmp.New(200).SetRange(60, 10), 1, 2},
+		{"adjacent words joined", btmp.New(128).SetBit(63).SetBit(64), 1, 2},
+		{"alternating", btmp.New(130).SetPositions(0, 2, 4, 64, 66, 129), 6, 5},
+		{"run ends at partial tail", btmp.New(70).SetRange(65, 5), 1, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.b.CountRuns(true); got != tt.ones {
+			t.Errorf("%s: CountRuns(true) expected %d, got %d", tt.name, tt.ones, got)
+		}
+		if got := tt.b.CountRuns(false); got != tt.zeros {
+			t.Errorf("%s: CountRuns(false) expected %d, got %d", tt.name, tt.zeros, got)
+		}
+	}
+}
+
+// TestBitmapDensity validates Bitmap.Density() ratio.
+func TestBitmapDensity(t *testing.T) {
+	tests := []struct {
+		name string
+		b    *btmp.Bitmap
+		want float64
+	}{
+		{"empty bitmap", btmp.New(0), 0},
+		{"no bits set", btmp.New(100), 0},
+		{"all bits set", btmp.New(70).SetAll(), 1},
+		{"quarter set", btmp.New(200).SetRange(0, 50), 0.25},
+		{"after truncate", btmp.New(128).SetAll().Truncate(65), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.b.Density(); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
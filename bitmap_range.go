@@ -19,6 +19,11 @@ func bitOffset(pos int) int {
 	return pos & IndexMask
 }
 
+// wordCount returns the number of words needed to hold n bits.
+func wordCount(n int) int {
+	return (n + IndexMask) >> WordShift
+}
+
 // rangeWordIndices returns the first and last word indices for a bit range.
 func rangeWordIndices(start, count int) (w0, w1 int) {
 	if count == 0 {
@@ -96,6 +101,14 @@ func (b *Bitmap) clearRange(start, count int) {
 	}
 }
 
+// flipRange toggles bits in [start, start+count).
+// Internal implementation - no validation, no auto-growth, no finalization.
+func (b *Bitmap) flipRange(start, count int) {
+	for word, mask := range b.rangeWords(start, count) {
+		*word ^= mask
+	}
+}
+
 // anyRange reports whether any bit in [start, start+count) is set.
 // Internal implementation - no validation.
 func (b *Bitmap) anyRange(start, count int) bool {
@@ -142,9 +155,9 @@ func (b *Bitmap) countRange(start, count int) int {
 
 // copyRange copies count bits from src[srcStart:] to dst[dstStart:].
 // Internal implementation - no validation, no auto-growth, no finalization.
-// Overlap-safe with memmove semantics.
+// Overlap-safe with memmove semantics. Only a self-copy to the same offset is a no-op.
 func (b *Bitmap) copyRange(src *Bitmap, srcStart, dstStart, count int) {
-	if count == 0 || srcStart == dstStart {
+	if count == 0 || (src == b && srcStart == dstStart) {
 		return
 	}
 
@@ -0,0 +1,105 @@
+package btmp_test
+
+import (
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestBitmapCopyRange validates Bitmap.CopyRange() between bitmaps.
+func TestBitmapCopyRange(t *testing.T) {
+	t.Run("copies between bitmaps at same offset", func(t *testing.T) {
+		src := btmp.New(100).SetRange(10, 70)
+		dst := btmp.New(100).CopyRange(src, 0, 0, 100)
+		if dst.Count() != 70 || !dst.AllRange(10, 70) {
+			t.Errorf("expected [10, 80) copied, got count=%d", dst.Count())
+		}
+	})
+
+	t.Run("self copy to same offset is a no-op", func(t *testing.T) {
+		b := btmp.New(100).SetRange(10, 70)
+		b.CopyRange(b, 10, 10, 70)
+		if b.Count() != 70 {
+			t.Errorf("expected count=70, got %d", b.Count())
+		}
+	})
+}
+
+// TestBitmapFlipRange validates Bitmap.FlipRange() range mutator.
+func TestBitmapFlipRange(t *testing.T) {
+	t.Run("toggles bits across words", func(t *testing.T) {
+		b := btmp.New(200).SetRange(60, 10)
+		b.FlipRange(50, 100)
+
+		if b.Count() != 90 {
+			t.Errorf("expected count=90, got %d", b.Count())
+		}
+		if b.AnyRange(60, 10) {
+			t.Error("expected [60, 70) cleared")
+		}
+		if !b.AllRange(50, 10) || !b.AllRange(70, 80) {
+			t.Error("expected [50, 60) and [70, 150) set")
+		}
+	})
+
+	t.Run("double flip restores", func(t *testing.T) {
+		b := btmp.New(100).SetBit(3).SetBit(64)
+		b.FlipRange(0, 100).FlipRange(0, 100)
+		if b.Count() != 2 || !b.Test(3) || !b.Test(64) {
+			t.Error("expected original bits after double flip")
+		}
+	})
+
+	t.Run("keeps tail masked", func(t *testing.T) {
+		b := btmp.New(70).FlipRange(0, 70)
+		if b.Count() != 70 {
+			t.Errorf("expected count=70, got %d", b.Count())
+		}
+		b.EnsureBits(128)
+		if b.Count() != 70 {
+			t.Errorf("expected count=70 after growth, got %d", b.Count())
+		}
+	})
+
+	t.Run("no-op for zero count", func(t *testing.T) {
+		b := btmp.New(10).FlipRange(5, 0)
+		if b.Any() {
+			t.Error("expected no bits set")
+		}
+	})
+
+	t.Run("panics on out-of-bounds range", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds range")
+			}
+		}()
+		btmp.New(10).FlipRange(5, 6)
+	})
+}
+
+// TestBitmapSetRangeValue validates Bitmap.SetRangeValue() dispatch.
+func TestBitmapSetRangeValue(t *testing.T) {
+	t.Run("true sets range", func(t *testing.T) {
+		b := btmp.New(100).SetRangeValue(10, 70, true)
+		if b.Count() != 70 || !b.AllRange(10, 70) {
+			t.Error("expected [10, 80) set")
+		}
+	})
+
+	t.Run("false clears range", func(t *testing.T) {
+		b := btmp.New(100).SetAll().SetRangeValue(10, 70, false)
+		if b.Count() != 30 || b.AnyRange(10, 70) {
+			t.Error("expected [10, 80) cleared")
+		}
+	})
+
+	t.Run("panics on out-of-bounds range", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds range")
+			}
+		}()
+		btmp.New(10).SetRangeValue(0, 11, true)
+	})
+}
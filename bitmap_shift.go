@@ -0,0 +1,121 @@
+package btmp
+
+import "math/bits"
+
+// reverse mirrors all bits in [0, Len()) so bit i moves to Len()-1-i.
+// Internal implementation - no validation, no finalization.
+//
+// Algorithm:
+//  1. Reverse word order and the bits within each word (bits.Reverse64).
+//     Bit i now sits at n*64-1-i, where n is the logical word count.
+//  2. Shift the whole sequence down by n*64-Len() to land at Len()-1-i.
+func (b *Bitmap) reverse() {
+	if b.lenBits == 0 {
+		return
+	}
+
+	n := b.lastWordIdx + 1
+	words := b.words[:n]
+	for i, j := 0, n-1; i <= j; i, j = i+1, j-1 {
+		words[i], words[j] = bits.Reverse64(words[j]), bits.Reverse64(words[i])
+	}
+
+	if s := uint(n*WordBits - b.lenBits); s != 0 {
+		for i := range n - 1 {
+			words[i] = words[i]>>s | words[i+1]<<(WordBits-s)
+		}
+		words[n-1] >>= s
+	}
+	words[n-1] &= b.tailMask
+}
+
+// reverseRange mirrors bits in [start, start+count) in place.
+// Swaps up to 64-bit chunks from both ends toward the middle.
+// Internal implementation - no validation, no finalization.
+func (b *Bitmap) reverseRange(start, count int) {
+	lo, hi := start, start+count
+	for hi-lo >= 2 {
+		k := min(WordBits, (hi-lo)/2)
+		shift := uint(WordBits - k)
+		head := b.getBits(lo, k)
+		tail := b.getBits(hi-k, k)
+		b.setBits(lo, k, bits.Reverse64(tail)>>shift)
+		b.setBits(hi-k, k, bits.Reverse64(head)>>shift)
+		lo += k
+		hi -= k
+	}
+}
+
+// shiftUp moves every bit i in [0, Len()) to i+k, dropping bits that reach
+// Len() and filling vacated low positions with zeros. Clears all bits if
+// k >= Len(). Internal implementation - no validation, no finalization.
+func (b *Bitmap) shiftUp(k int) {
+	if b.lenBits == 0 || k == 0 {
+		return
+	}
+	if k >= b.lenBits {
+		b.clearAll()
+		return
+	}
+
+	n := b.lastWordIdx + 1
+	ws := wordIdx(k)
+	bs := uint(bitOffset(k))
+	for i := n - 1; i >= 0; i-- {
+		var v uint64
+		if src := i - ws; src >= 0 {
+			v = b.words[src] << bs
+			if bs != 0 && src > 0 {
+				v |= b.words[src-1] >> (WordBits - bs)
+			}
+		}
+		b.words[i] = v
+	}
+	b.words[b.lastWordIdx] &= b.tailMask
+}
+
+// shiftDown moves every bit i in [0, Len()) to i-k, dropping bits below 0
+// and filling vacated high positions with zeros. Clears all bits if
+// k >= Len(). Internal implementation - no validation, no finalization.
+func (b *Bitmap) shiftDown(k int) {
+	if b.lenBits == 0 || k == 0 {
+		return
+	}
+	if k >= b.lenBits {
+		b.clearAll()
+		return
+	}
+
+	n := b.lastWordIdx + 1
+	ws := wordIdx(k)
+	bs := uint(bitOffset(k))
+	for i := range n {
+		var v uint64
+		if src := i + ws; src < n {
+			v = b.words[src] >> bs
+			if bs != 0 && src+1 < n {
+				v |= b.words[src+1] << (WordBits - bs)
+			}
+		}
+		b.words[i] = v
+	}
+	b.words[b.lastWordIdx] &= b.tailMask
+}
+
+// rotateUp moves every bit i in [0, Len()) to (i+k) mod Len().
+// The wrapped high bits are shifted down in a scratch copy and ORed back in.
+// Internal implementation - no validation, no finalization.
+func (b *Bitmap) rotateUp(k int) {
+	if b.lenBits == 0 {
+		return
+	}
+	k %= b.lenBits
+	if k == 0 {
+		return
+	}
+
+	wrapped := b.Clone()
+	wrapped.shiftDown(b.lenBits - k)
+	b.shiftUp(k)
+	b.or(wrapped)
+}
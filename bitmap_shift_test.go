@@ -0,0 +1,157 @@
+package btmp_test
+
+import (
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// pattern returns a bitmap of n bits with every bit i set where i%3 == 0 or i%7 == 0.
+func pattern(n int) *btmp.Bitmap {
+	b := btmp.New(uint(n))
+	for i := range n {
+		if i%3 == 0 || i%7 == 0 {
+			b.SetBit(i)
+		}
+	}
+	return b
+}
+
+// TestBitmapReverse validates Bitmap.Reverse() mirroring.
+func TestBitmapReverse(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 63, 64, 65, 100, 128, 200} {
+		src := pattern(n)
+		b := src.Clone().Reverse()
+
+		if b.Len() != n || b.Count() != src.Count() {
+			t.Errorf("n=%d: expected len=%d count=%d, got len=%d count=%d", n, n, src.Count(), b.Len(), b.Count())
+		}
+		for i := range n {
+			if b.Test(i) != src.Test(n-1-i) {
+				t.Errorf("n=%d: bit %d mismatch", n, i)
+				break
+			}
+		}
+	}
+
+	t.Run("keeps tail masked", func(t *testing.T) {
+		b := btmp.New(70).SetBit(0).Reverse().EnsureBits(128)
+		if b.Count() != 1 || !b.Test(69) {
+			t.Error("expected only bit 69 set")
+		}
+	})
+}
+
+// TestBitmapReverseRange validates Bitmap.ReverseRange() mirroring.
+func TestBitmapReverseRange(t *testing.T) {
+	for _, tt := range []struct{ start, count int }{
+		{0, 0}, {0, 1}, {3, 2}, {5, 17}, {10, 128}, {1, 199}, {60, 130},
+	} {
+		src := pattern(200)
+		b := src.Clone().ReverseRange(tt.start, tt.count)
+
+		for i := range 200 {
+			want := src.Test(i)
+			if i >= tt.start && i < tt.start+tt.count {
+				want = src.Test(tt.start + tt.count - 1 - (i - tt.start))
+			}
+			if b.Test(i) != want {
+				t.Errorf("ReverseRange(%d, %d): bit %d mismatch", tt.start, tt.count, i)
+				break
+			}
+		}
+	}
+
+	t.Run("panics on out-of-bounds range", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds range")
+			}
+		}()
+		btmp.New(10).ReverseRange(5, 6)
+	})
+}
+
+// TestBitmapRotate validates Bitmap.RotateLeft() and Bitmap.RotateRight().
+func TestBitmapRotate(t *testing.T) {
+	for _, n := range []int{1, 5, 63, 64, 65, 100, 128, 200} {
+		for _, k := range []int{0, 1, 7, 63, 64, 65, 130, n, 3 * n} {
+			src := pattern(n)
+
+			left := src.Clone().RotateLeft(k)
+			right := src.Clone().RotateRight(k)
+			if left.Count() != src.Count() || right.Count() != src.Count() {
+				t.Errorf("n=%d k=%d: expected count preserved", n, k)
+			}
+			for i := range n {
+				if left.Test((i+k)%n) != src.Test(i) {
+					t.Errorf("n=%d k=%d: RotateLeft bit %d mismatch", n, k, i)
+					break
+				}
+				if right.Test(((i-k)%n+n)%n) != src.Test(i) {
+					t.Errorf("n=%d k=%d: RotateRight bit %d mismatch", n, k, i)
+					break
+				}
+			}
+		}
+	}
+
+	t.Run("empty bitmap is a no-op", func(t *testing.T) {
+		b := btmp.New(0).RotateLeft(5).RotateRight(5)
+		if b.Len() != 0 {
+			t.Errorf("expected len=0, got %d", b.Len())
+		}
+	})
+
+	t.Run("panics on negative n", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative n")
+			}
+		}()
+		btmp.New(10).RotateLeft(-1)
+	})
+}
+
+// TestBitmapShift validates Bitmap.ShiftLeft() and Bitmap.ShiftRight().
+func TestBitmapShift(t *testing.T) {
+	for _, n := range []int{1, 5, 63, 64, 65, 100, 128, 200} {
+		for _, k := range []int{0, 1, 7, 63, 64, 65, 130, n, n + 1} {
+			src := pattern(n)
+
+			left := src.Clone().ShiftLeft(k)
+			right := src.Clone().ShiftRight(k)
+			if left.Len() != n || right.Len() != n {
+				t.Errorf("n=%d k=%d: expected len unchanged", n, k)
+			}
+			for i := range n {
+				wantLeft := i-k >= 0 && src.Test(i-k)
+				if left.Test(i) != wantLeft {
+					t.Errorf("n=%d k=%d: ShiftLeft bit %d mismatch", n, k, i)
+					break
+				}
+				wantRight := i+k < n && src.Test(i+k)
+				if right.Test(i) != wantRight {
+					t.Errorf("n=%d k=%d: ShiftRight bit %d mismatch", n, k, i)
+					break
+				}
+			}
+		}
+	}
+
+	t.Run("keeps tail masked", func(t *testing.T) {
+		b := btmp.New(70).SetAll().ShiftLeft(10).EnsureBits(128)
+		if b.Count() != 60 || !b.AllRange(10, 60) {
+			t.Errorf("expected [10, 70) set, got count=%d", b.Count())
+		}
+	})
+
+	t.Run("panics on negative n", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative n")
+			}
+		}()
+		btmp.New(10).ShiftRight(-1)
+	})
+}
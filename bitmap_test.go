@@ -1,6 +1,7 @@
 package btmp_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/neox5/btmp"
@@ -389,3 +390,347 @@ func TestBitmapCount(t *testing.T) {
 		}
 	})
 }
+
+// TestBitmapClone validates Bitmap.Clone() copy behavior.
+func TestBitmapClone(t *testing.T) {
+	t.Run("copies length and bits", func(t *testing.T) {
+		b := btmp.New(100)
+		b.SetBit(0).SetBit(63).SetBit(64).SetBit(99)
+
+		c := b.Clone()
+		if c.Len() != 100 {
+			t.Errorf("expected len=100, got %d", c.Len())
+		}
+		for _, pos := range []int{0, 63, 64, 99} {
+			if !c.Test(pos) {
+				t.Errorf("expected bit %d set in clone", pos)
+			}
+		}
+		if c.Count() != 4 {
+			t.Errorf("expected count=4, got %d", c.Count())
+		}
+	})
+
+	t.Run("does not share storage", func(t *testing.T) {
+		b := btmp.New(128)
+		b.SetBit(10)
+
+		c := b.Clone()
+		c.SetBit(20).ClearBit(10)
+		b.SetBit(30)
+
+		if !b.Test(10) || b.Test(20) {
+			t.Error("expected original unaffected by clone mutation")
+		}
+		if c.Test(30) {
+			t.Error("expected clone unaffected by original mutation")
+		}
+	})
+
+	t.Run("clones empty bitmap", func(t *testing.T) {
+		c := btmp.New(0).Clone()
+		if c.Len() != 0 {
+			t.Errorf("expected len=0, got %d", c.Len())
+		}
+		c.AddBits(10).SetBit(9)
+		if !c.Test(9) {
+			t.Error("expected clone of empty bitmap to be usable")
+		}
+	})
+}
+
+// TestNewFromWords validates NewFromWords constructor behavior.
+func TestNewFromWords(t *testing.T) {
+	t.Run("wraps words with given length", func(t *testing.T) {
+		b := btmp.NewFromWords([]uint64{0b1011, 1}, 65)
+		if b.Len() != 65 {
+			t.Errorf("expected len=65, got %d", b.Len())
+		}
+		if b.Count() != 4 || !b.Test(0) || !b.Test(3) || !b.Test(64) {
+			t.Error("expected bits from words")
+		}
+	})
+
+	t.Run("takes ownership without copying", func(t *testing.T) {
+		words := []uint64{0}
+		b := btmp.NewFromWords(words, 64)
+		b.SetBit(5)
+		if words[0] != 1<<5 {
+			t.Errorf("expected shared storage, got words[0]=%#x", words[0])
+		}
+	})
+
+	t.Run("masks bits beyond n", func(t *testing.T) {
+		b := btmp.NewFromWords([]uint64{btmp.WordMask, btmp.WordMask}, 10)
+		if b.Count() != 10 {
+			t.Errorf("expected count=10, got %d", b.Count())
+		}
+		b.EnsureBits(128)
+		if b.Count() != 10 {
+			t.Errorf("expected count=10 after growth, got %d", b.Count())
+		}
+	})
+
+	t.Run("accepts empty input", func(t *testing.T) {
+		b := btmp.NewFromWords(nil, 0)
+		if b.Len() != 0 || b.Any() {
+			t.Error("expected empty bitmap")
+		}
+	})
+
+	t.Run("round-trips with Words", func(t *testing.T) {
+		src := btmp.New(100).SetRange(30, 50)
+		b := btmp.NewFromWords(src.Words(), uint(src.Len()))
+		if b.Count() != 50 || !b.AllRange(30, 50) {
+			t.Error("expected identical bits")
+		}
+	})
+
+	t.Run("panics when words too short", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for short words")
+			}
+		}()
+		btmp.NewFromWords([]uint64{0}, 65)
+	})
+}
+
+// TestBitmapSetPositions validates Bitmap.SetPositions() bulk mutator.
+func TestBitmapSetPositions(t *testing.T) {
+	t.Run("sets listed positions", func(t *testing.T) {
+		b := btmp.New(200)
+		b.SetPositions(199, 0, 63, 64, 1, 130, 64)
+
+		want := []int{0, 1, 63, 64, 130, 199}
+		if b.Count() != len(want) {
+			t.Errorf("expected count=%d, got %d", len(want), b.Count())
+		}
+		for _, pos := range want {
+			if !b.Test(pos) {
+				t.Errorf("expected bit %d set", pos)
+			}
+		}
+	})
+
+	t.Run("no-op for empty argument list", func(t *testing.T) {
+		b := btmp.New(10).SetPositions()
+		if b.Any() {
+			t.Error("expected no bits set")
+		}
+	})
+
+	t.Run("panics without mutating on invalid position", func(t *testing.T) {
+		b := btmp.New(10)
+		defer func() {
+			r := recover()
+			if r == nil {
+				t.Fatal("expected panic for out-of-bounds position")
+			}
+			err, ok := r.(*btmp.ValidationError)
+			if !ok || err.Field != "positions[2]" {
+				t.Errorf("expected ValidationError for positions[2], got %v", r)
+			}
+			if b.Any() {
+				t.Error("expected no bits set after failed validation")
+			}
+		}()
+		b.SetPositions(1, 2, 10, -1)
+	})
+
+	t.Run("names negative position", func(t *testing.T) {
+		defer func() {
+			err, ok := recover().(*btmp.ValidationError)
+			if !ok || err.Field != "positions[1]" {
+				t.Errorf("expected ValidationError for positions[1], got %v", err)
+			}
+		}()
+		btmp.New(10).SetPositions(3, -1)
+	})
+
+	t.Run("valid positions do not allocate", func(t *testing.T) {
+		b := btmp.New(100)
+		positions := []int{0, 5, 63, 64, 99}
+		allocs := testing.AllocsPerRun(100, func() {
+			b.SetPositions(positions...)
+		})
+		if allocs != 0 {
+			t.Errorf("expected 0 allocations, got %v", allocs)
+		}
+	})
+}
+
+// TestBitmapAppendBits validates Bitmap.AppendBits() stream building.
+func TestBitmapAppendBits(t *testing.T) {
+	t.Run("assembles packed stream", func(t *testing.T) {
+		b := btmp.New(0)
+		b.AppendBits(4, 0xA).AppendBits(60, 0).AppendBits(8, 0xFF)
+
+		if b.Len() != 72 {
+			t.Errorf("expected len=72, got %d", b.Len())
+		}
+		if got := b.Words()[0] & 0xF; got != 0xA {
+			t.Errorf("expected low nibble 0xA, got %#x", got)
+		}
+		if !b.AllRange(64, 8) || b.Count() != 10 {
+			t.Errorf("expected 0xFF at [64, 72), count=10, got count=%d", b.Count())
+		}
+	})
+
+	t.Run("ignores high bits of val", func(t *testing.T) {
+		b := btmp.New(0).AppendBits(3, btmp.WordMask)
+		if b.Len() != 3 || b.Count() != 3 {
+			t.Errorf("expected len=3 count=3, got len=%d count=%d", b.Len(), b.Count())
+		}
+		b.EnsureBits(64)
+		if b.Count() != 3 {
+			t.Errorf("expected no bits beyond appended field, got count=%d", b.Count())
+		}
+	})
+
+	t.Run("preserves prior bits across word boundary", func(t *testing.T) {
+		b := btmp.New(60).SetAll()
+		b.AppendBits(64, 0)
+		if b.Len() != 124 || b.Count() != 60 {
+			t.Errorf("expected len=124 count=60, got len=%d count=%d", b.Len(), b.Count())
+		}
+	})
+
+	t.Run("panics on invalid n", func(t *testing.T) {
+		for _, n := range []int{0, -1, 65} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("expected panic for n=%d", n)
+					}
+				}()
+				btmp.New(0).AppendBits(n, 0)
+			}()
+		}
+	})
+}
+
+// TestBitmapGetBits validates Bitmap.GetBits() multi-bit read.
+func TestBitmapGetBits(t *testing.T) {
+	t.Run("reads value written by SetBits", func(t *testing.T) {
+		b := btmp.New(200)
+		for _, pos := range []int{0, 5, 60, 64, 100, 136} {
+			b.ClearAll().SetBits(pos, 64, 0xDEADBEEFCAFEF00D)
+			if got := b.GetBits(pos, 64); got != 0xDEADBEEFCAFEF00D {
+				t.Errorf("pos=%d: expected %#x, got %#x", pos, uint64(0xDEADBEEFCAFEF00D), got)
+			}
+		}
+	})
+
+	t.Run("reads right-aligned partial field", func(t *testing.T) {
+		b := btmp.New(100).SetBits(60, 8, 0xA5)
+		if got := b.GetBits(60, 8); got != 0xA5 {
+			t.Errorf("expected 0xa5, got %#x", got)
+		}
+		if got := b.GetBits(62, 4); got != 0x9 {
+			t.Errorf("expected 0x9, got %#x", got)
+		}
+	})
+
+	t.Run("panics on invalid arguments", func(t *testing.T) {
+		for _, tt := range []struct{ pos, n int }{{-1, 1}, {0, 0}, {0, 65}, {95, 6}} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("expected panic for pos=%d n=%d", tt.pos, tt.n)
+					}
+				}()
+				btmp.New(100).GetBits(tt.pos, tt.n)
+			}()
+		}
+	})
+}
+
+// TestBitmapSlice validates Bitmap.Slice() extraction.
+func TestBitmapSlice(t *testing.T) {
+	src := btmp.New(300)
+	for i := 0; i < 300; i += 7 {
+		src.SetBit(i)
+	}
+
+	for _, tt := range []struct{ start, count int }{
+		{0, 0}, {0, 1}, {0, 300}, {3, 61}, {60, 10}, {64, 128}, {99, 200},
+	} {
+		s := src.Slice(tt.start, tt.count)
+		if s.Len() != tt.count {
+			t.Errorf("Slice(%d, %d): expected len=%d, got %d", tt.start, tt.count, tt.count, s.Len())
+		}
+		for i := range tt.count {
+			if s.Test(i) != src.Test(tt.start+i) {
+				t.Errorf("Slice(%d, %d): bit %d mismatch", tt.start, tt.count, i)
+				break
+			}
+		}
+		if s.Count() != src.CountRange(tt.start, tt.count) {
+			t.Errorf("Slice(%d, %d): expected count=%d, got %d", tt.start, tt.count, src.CountRange(tt.start, tt.count), s.Count())
+		}
+	}
+
+	t.Run("result is independent", func(t *testing.T) {
+		src.Slice(0, 10).SetAll()
+		if src.CountRange(0, 10) != 2 {
+			t.Error("expected source unchanged")
+		}
+	})
+
+	t.Run("panics on out-of-bounds range", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds range")
+			}
+		}()
+		src.Slice(250, 51)
+	})
+}
+
+// TestBitmapTryMutators validates TrySetBit(), TryClearBit(), and TrySetRange().
+func TestBitmapTryMutators(t *testing.T) {
+	t.Run("valid calls mutate", func(t *testing.T) {
+		b := btmp.New(100)
+		if err := b.TrySetBit(70); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := b.TrySetRange(10, 60); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := b.TryClearBit(10); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := b.Count(); got != 60 {
+			t.Errorf("expected 60 set bits, got %d", got)
+		}
+		if b.Test(10) || !b.Test(11) || !b.Test(70) {
+			t.Errorf("unexpected bits: %s", b.Print())
+		}
+	})
+
+	t.Run("invalid calls return error and leave bitmap unchanged", func(t *testing.T) {
+		b := btmp.New(100).SetBit(5)
+		tests := []struct {
+			name string
+			err  error
+			want error
+		}{
+			{"TrySetBit negative", b.TrySetBit(-1), btmp.ErrNegative},
+			{"TrySetBit out of bounds", b.TrySetBit(100), btmp.ErrOutOfBounds},
+			{"TryClearBit out of bounds", b.TryClearBit(100), btmp.ErrOutOfBounds},
+			{"TrySetRange out of bounds", b.TrySetRange(90, 11), btmp.ErrOutOfBounds},
+			{"TrySetRange negative", b.TrySetRange(0, -1), btmp.ErrNegative},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if !errors.Is(tt.err, tt.want) {
+					t.Errorf("expected %v, got %v", tt.want, tt.err)
+				}
+			})
+		}
+		if b.Count() != 1 || !b.Test(5) {
+			t.Errorf("expected bitmap unchanged, got %s", b.Print())
+		}
+	})
+}
@@ -10,6 +10,21 @@ func (b *Bitmap) validateInBounds(pos int) error {
 			Field:   "pos",
 			Value:   fmt.Sprintf("pos=%d, len=%d", pos, b.lenBits),
 			Message: "position out of bounds",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// validateInBoundsInclusive validates that position is within [0, Len()].
+// Returns ValidationError if pos > bitmap length.
+func (b *Bitmap) validateInBoundsInclusive(pos int) error {
+	if pos > b.lenBits {
+		return &ValidationError{
+			Field:   "pos",
+			Value:   fmt.Sprintf("pos=%d, len=%d", pos, b.lenBits),
+			Message: "position out of bounds",
+			Err:     ErrOutOfBounds,
 		}
 	}
 	return nil
@@ -33,6 +48,37 @@ func (b *Bitmap) validateRange(start, count int) error {
 			Field:   "range",
 			Value:   fmt.Sprintf("start=%d, count=%d, len=%d", start, count, b.lenBits),
 			Message: "exceeds bitmap bounds",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// validateAt validates that other is non-nil and fits in [offset, offset+other.Len()).
+func (b *Bitmap) validateAt(offset int, other *Bitmap) error {
+	if err := validateNotNil(other, "other"); err != nil {
+		return err
+	}
+	if err := validateNonNegative(offset, "offset"); err != nil {
+		return err
+	}
+	return b.validateRange(offset, other.lenBits)
+}
+
+// validatePositions validates that every position is within [0, Len()).
+// Returns ValidationError naming the first invalid index.
+func (b *Bitmap) validatePositions(positions []int) error {
+	for i, pos := range positions {
+		if pos < 0 {
+			return validateNonNegative(pos, fmt.Sprintf("positions[%d]", i))
+		}
+		if pos >= b.lenBits {
+			return &ValidationError{
+				Field:   fmt.Sprintf("positions[%d]", i),
+				Value:   fmt.Sprintf("pos=%d, len=%d", pos, b.lenBits),
+				Message: "position out of bounds",
+				Err:     ErrOutOfBounds,
+			}
 		}
 	}
 	return nil
@@ -1,5 +1,12 @@
 package btmp
 
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"iter"
+)
+
 // Grid is a zero-copy row-major view over a Bitmap.
 // Cols is the fixed number of columns per row. Grid mutators keep
 // Len() == Rows()*Cols after each operation.
@@ -39,6 +46,53 @@ func NewGridWithSize(rows, cols int) *Grid {
 	}
 }
 
+// GridFromBools returns a len(m)×len(m[0]) grid with cell (r,c) set where
+// m[r][c] is true. An empty m yields a 0×0 grid. The matrix is copied.
+// Panics if the rows of m differ in length or the size overflows.
+func GridFromBools(m [][]bool) *Grid {
+	if err := validateRectangular(m); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.GridFromBools"))
+	}
+	if len(m) > 0 {
+		if err := validateGridSizeMax(len(m), len(m[0])); err != nil {
+			panic(err.(*ValidationError).WithContext("Grid.GridFromBools"))
+		}
+	}
+	return gridFromBools(m)
+}
+
+// ParseGrid builds a grid from a multi-line string in which setRune marks set
+// cells and any other rune marks clear cells. Two layouts are accepted:
+//
+//   - The labeled layout produced by Print: a header of column indices
+//     followed by one row per line, each prefixed with its row index and
+//     holding whitespace-separated cells. Input is strict; every row must
+//     hold exactly as many cells as the header has columns.
+//   - Plain ASCII art with one rune per cell. Rows() is the number of lines
+//     and Cols() the length of the longest line; shorter lines are padded
+//     with clear cells.
+//
+// Leading and trailing empty lines are ignored, so ParseGrid(g.Print(), '#')
+// restores g. Returns ValidationError on malformed labeled input or if the
+// size overflows.
+func ParseGrid(s string, setRune rune) (*Grid, error) {
+	g, err := parseGrid(s, setRune)
+	if err != nil {
+		return nil, err.(*ValidationError).WithContext("Grid.ParseGrid")
+	}
+	return g, nil
+}
+
+// Clone returns a deep copy of g with the same Rows() and Cols() and an
+// independent, minimally sized backing Bitmap.
+func (g *Grid) Clone() *Grid {
+	return &Grid{
+		B:    g.B.Clone(),
+		cols: g.cols,
+		rows: g.rows,
+	}
+}
+
 // ========================================
 // Accessors
 // ========================================
@@ -113,6 +167,76 @@ func (g *Grid) GrowRows(delta int) *Grid {
 	return g
 }
 
+// ShrinkRows reduces Rows() by delta, discarding the bottom rows and clearing
+// their bits. Returns g. Panics if delta < 0 or delta > Rows().
+func (g *Grid) ShrinkRows(delta int) *Grid {
+	if err := validateShrink(delta, g.rows, "rows"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShrinkRows"))
+	}
+	if delta > 0 {
+		g.shrinkRows(delta)
+	}
+	return g
+}
+
+// ShrinkCols reduces Cols() by delta, discarding the rightmost columns and
+// repositioning each remaining row to the smaller stride so every kept cell
+// (r,c) stays at the same coordinates. Returns g.
+// Panics if delta < 0 or delta > Cols().
+func (g *Grid) ShrinkCols(delta int) *Grid {
+	if err := validateShrink(delta, g.cols, "cols"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShrinkCols"))
+	}
+	if delta > 0 {
+		g.shrinkCols(delta)
+	}
+	return g
+}
+
+// InsertRow inserts an empty row at index at, shifting rows [at, Rows())
+// down by one. at == Rows() appends. Cols() is unchanged.
+// Returns g. Panics if at < 0 or at > Rows().
+func (g *Grid) InsertRow(at int) *Grid {
+	if err := validateAt(at, g.rows+1, "rows"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.InsertRow"))
+	}
+	g.insertRow(at)
+	return g
+}
+
+// RemoveRow deletes row at, shifting rows below it up by one and reducing
+// Rows() by one. Cols() is unchanged.
+// Returns g. Panics if at < 0 or at >= Rows().
+func (g *Grid) RemoveRow(at int) *Grid {
+	if err := validateAt(at, g.rows, "rows"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.RemoveRow"))
+	}
+	g.removeRow(at)
+	return g
+}
+
+// InsertCol inserts an empty column at index at, shifting cells in columns
+// [at, Cols()) right by one in every row. at == Cols() appends.
+// Returns g. Panics if at < 0 or at > Cols().
+func (g *Grid) InsertCol(at int) *Grid {
+	if err := validateAt(at, g.cols+1, "cols"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.InsertCol"))
+	}
+	g.insertCol(at)
+	return g
+}
+
+// RemoveCol deletes column at, shifting cells in columns (at, Cols()) left by
+// one in every row and reducing Cols() by one.
+// Returns g. Panics if at < 0 or at >= Cols().
+func (g *Grid) RemoveCol(at int) *Grid {
+	if err := validateAt(at, g.cols, "cols"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.RemoveCol"))
+	}
+	g.removeCol(at)
+	return g
+}
+
 // ========================================
 // Query Operations
 // ========================================
@@ -135,6 +259,57 @@ func (g *Grid) RectOne(r, c, h, w int) bool {
 	return g.rectOne(r, c, h, w)
 }
 
+// AnyRect reports whether any cell in the specified rectangle is set.
+// It is the inverse of RectZero. Panics if rectangle is invalid or out of bounds.
+func (g *Grid) AnyRect(r, c, h, w int) bool {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.AnyRect"))
+	}
+	return g.anyRect(r, c, h, w)
+}
+
+// AllRect reports whether every cell in the specified rectangle is set.
+// Same result as RectOne. Panics if rectangle is invalid or out of bounds.
+func (g *Grid) AllRect(r, c, h, w int) bool {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.AllRect"))
+	}
+	return g.allRect(r, c, h, w)
+}
+
+// CountRect returns the number of set cells in the specified rectangle.
+// Panics if rectangle is invalid or out of bounds.
+func (g *Grid) CountRect(r, c, h, w int) int {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CountRect"))
+	}
+	return g.countRect(r, c, h, w)
+}
+
+// CollidesAt reports whether overlaying src at (dstR,dstC) would place any of
+// its set cells on a set cell of g. Neither grid is modified.
+// Panics under the same conditions as OverlayAt.
+func (g *Grid) CollidesAt(dstR, dstC int, src *Grid) bool {
+	if err := g.validateOverlay(dstR, dstC, src); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CollidesAt"))
+	}
+	return g.collidesAt(dstR, dstC, src)
+}
+
+// FindFreeRect returns the top-left-most origin (r,c) at which an h×w
+// rectangle fits with all cells zero, scanning rows top-to-bottom and columns
+// left-to-right. Returns ok=false if no such placement exists.
+// Panics if h <= 0 or w <= 0.
+func (g *Grid) FindFreeRect(h, w int) (r, c int, ok bool) {
+	if err := validatePositive(h, "h"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.FindFreeRect"))
+	}
+	if err := validatePositive(w, "w"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.FindFreeRect"))
+	}
+	return g.findFreeRect(h, w)
+}
+
 // NextZeroInRow returns the column index of the next zero bit in row r,
 // starting search from column c.
 // Search is constrained to row r only - does not continue to next row.
@@ -189,6 +364,70 @@ func (g *Grid) NextOneInRowRange(r, c, count int) int {
 	return g.nextOneInRowRange(r, c, count)
 }
 
+// NextZeroInCol returns the row index of the next zero cell in column c,
+// starting search from row r.
+// Search is constrained to column c only. Cells are strided by Cols(), so
+// this is a per-row scan: O(Rows()-r).
+// Returns -1 if no zero cell exists in [r, Rows()).
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) NextZeroInCol(r, c int) int {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.NextZeroInCol"))
+	}
+	return g.nextBitInCol(r, c, false)
+}
+
+// NextOneInCol returns the row index of the next set cell in column c,
+// starting search from row r.
+// Search is constrained to column c only. Cells are strided by Cols(), so
+// this is a per-row scan: O(Rows()-r).
+// Returns -1 if no set cell exists in [r, Rows()).
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) NextOneInCol(r, c int) int {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.NextOneInCol"))
+	}
+	return g.nextBitInCol(r, c, true)
+}
+
+// NextFreeRow returns the row index of the next free (zero) cell in column c,
+// starting search from row r. Note the column-first argument order, naming
+// the fixed column before the scan start.
+// Search is constrained to column c only.
+// Returns -1 if no free cell exists in [r, Rows()).
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) NextFreeRow(c, r int) int {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.NextFreeRow"))
+	}
+	return g.nextBitInCol(r, c, false)
+}
+
+// FreeRowsFrom returns the count of consecutive free (zero) cells in column c
+// starting at row r and moving down.
+// Count is constrained to column c only - stops at the bottom edge.
+// Returns 0 if cell (r,c) is set. Runs in O(Rows()-r) without allocating.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) FreeRowsFrom(r, c int) int {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.FreeRowsFrom"))
+	}
+	return g.countBitsFromInCol(r, c, false)
+}
+
+// CanFitHeight reports whether cells [r, r+h) in column c are all free (zero).
+// Returns false, rather than panicking, when only r+h exceeds Rows().
+// Panics if h <= 0, r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) CanFitHeight(r, c, h int) bool {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CanFitHeight"))
+	}
+	if err := validatePositive(h, "h"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CanFitHeight"))
+	}
+	return g.canFitHeight(r, c, h)
+}
+
 // CountZerosFromInRow returns the count of consecutive zero bits in row r
 // starting at column c.
 // Count is constrained to row r only - stops at Cols() boundary.
@@ -215,6 +454,30 @@ func (g *Grid) CountOnesFromInRow(r, c int) int {
 	return g.countOnesFromInRow(r, c)
 }
 
+// CountZerosFromInCol returns the count of consecutive zero cells in column c
+// starting at row r and moving down.
+// Count is constrained to column c only - stops at the bottom edge.
+// Returns 0 if cell (r,c) is set.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) CountZerosFromInCol(r, c int) int {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CountZerosFromInCol"))
+	}
+	return g.countBitsFromInCol(r, c, false)
+}
+
+// CountOnesFromInCol returns the count of consecutive set cells in column c
+// starting at row r and moving down.
+// Count is constrained to column c only - stops at the bottom edge.
+// Returns 0 if cell (r,c) is zero.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) CountOnesFromInCol(r, c int) int {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CountOnesFromInCol"))
+	}
+	return g.countBitsFromInCol(r, c, true)
+}
+
 // CountZerosFromInRowRange returns the count of consecutive zero bits in row r
 // starting at column c, within [c, c+count).
 // Count is constrained to specified range only.
@@ -259,12 +522,127 @@ func (g *Grid) AllRow(r int) bool {
 			Field:   "r",
 			Value:   r,
 			Message: "out of bounds",
+			Err:     ErrOutOfBounds,
 			Context: "Grid.AllRow",
 		})
 	}
 	return g.allRow(r)
 }
 
+// AllCol returns true if all cells in column c are set.
+// Returns false for empty column (Rows() == 0).
+// Panics if c < 0 or c >= Cols().
+func (g *Grid) AllCol(c int) bool {
+	if err := g.validateCol(c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.AllCol"))
+	}
+	return g.allCol(c)
+}
+
+// AnyCol returns true if any cell in column c is set.
+// Returns false for empty column (Rows() == 0).
+// Panics if c < 0 or c >= Cols().
+func (g *Grid) AnyCol(c int) bool {
+	if err := g.validateCol(c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.AnyCol"))
+	}
+	return g.anyCol(c)
+}
+
+// CountRow returns the number of set cells in row r.
+// Returns 0 for empty row (Cols() == 0).
+// Panics if r < 0 or r >= Rows().
+func (g *Grid) CountRow(r int) int {
+	if err := g.validateRow(r); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CountRow"))
+	}
+	return g.countRow(r)
+}
+
+// CountCol returns the number of set cells in column c.
+// Returns 0 for empty column (Rows() == 0).
+// Panics if c < 0 or c >= Cols().
+func (g *Grid) CountCol(c int) int {
+	if err := g.validateCol(c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CountCol"))
+	}
+	return g.countCol(c)
+}
+
+// ========================================
+// Iteration
+// ========================================
+
+// Cells returns an iterator over the (row, col) coordinates of each set cell
+// in row-major order. Yields nothing for an empty grid. Stops early if the
+// consumer breaks.
+//
+//	for r, c := range g.Cells() { ... }
+func (g *Grid) Cells() iter.Seq2[int, int] {
+	return g.cells()
+}
+
+// ========================================
+// Logical Operations
+// ========================================
+
+// And performs cell-wise AND with other. Both grids must have the same
+// Rows() and Cols(). Returns *Grid for chaining.
+// Panics if other is nil or dimensions differ.
+func (g *Grid) And(other *Grid) *Grid {
+	if err := g.validateSameShape(other); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.And"))
+	}
+	g.B.and(other.B)
+	return g
+}
+
+// Or performs cell-wise OR with other. Both grids must have the same
+// Rows() and Cols(). Returns *Grid for chaining.
+// Panics if other is nil or dimensions differ.
+func (g *Grid) Or(other *Grid) *Grid {
+	if err := g.validateSameShape(other); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.Or"))
+	}
+	g.B.or(other.B)
+	return g
+}
+
+// Xor performs cell-wise XOR with other. Both grids must have the same
+// Rows() and Cols(). Returns *Grid for chaining.
+// Panics if other is nil or dimensions differ.
+func (g *Grid) Xor(other *Grid) *Grid {
+	if err := g.validateSameShape(other); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.Xor"))
+	}
+	g.B.xor(other.B)
+	return g
+}
+
+// Not inverts every cell in the grid. No-op for empty grids.
+// Returns *Grid for chaining.
+func (g *Grid) Not() *Grid {
+	g.B.not()
+	return g
+}
+
+// ========================================
+// Comparison Operations
+// ========================================
+
+// Equal reports whether g and other have identical Rows(), Cols(), and set cells.
+// Two 0x0 grids are equal. Panics if other is nil.
+func (g *Grid) Equal(other *Grid) bool {
+	if err := validateNotNil(other, "other"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.Equal"))
+	}
+
+	if g.rows != other.rows || g.cols != other.cols {
+		return false
+	}
+	return g.B.equal(other.B)
+}
+
 // ========================================
 // Validation Operations
 // ========================================
@@ -282,6 +660,171 @@ func (g *Grid) ValidateRect(r, c, h, w int) error {
 	return g.validateRect(r, c, h, w)
 }
 
+// ========================================
+// Cell Operations
+// ========================================
+
+// At reports whether cell (r,c) is set.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) At(r, c int) bool {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.At"))
+	}
+	return g.at(r, c)
+}
+
+// SetCell sets cell (r,c) to 1. Returns g.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) SetCell(r, c int) *Grid {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SetCell"))
+	}
+	g.setCell(r, c)
+	return g
+}
+
+// ClearCell sets cell (r,c) to 0. Returns g.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) ClearCell(r, c int) *Grid {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ClearCell"))
+	}
+	g.clearCell(r, c)
+	return g
+}
+
+// ToggleCell flips cell (r,c). Returns g.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) ToggleCell(r, c int) *Grid {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ToggleCell"))
+	}
+	g.toggleCell(r, c)
+	return g
+}
+
+// ========================================
+// Row and Column Operations
+// ========================================
+
+// Row returns a new, independent bitmap of Len() == Cols() holding a copy of
+// row r: bit c of the result is cell (r,c).
+// Panics if r < 0 or r >= Rows().
+func (g *Grid) Row(r int) *Bitmap {
+	if err := g.validateRow(r); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.Row"))
+	}
+	return g.row(r)
+}
+
+// Col returns a new, independent bitmap of Len() == Rows() holding a copy of
+// column c: bit r of the result is cell (r,c).
+// Panics if c < 0 or c >= Cols().
+func (g *Grid) Col(c int) *Bitmap {
+	if err := g.validateCol(c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.Col"))
+	}
+	return g.col(c)
+}
+
+// SetRow overwrites row r with src: cell (r,c) becomes src bit c.
+// src is not modified. Returns *Grid for chaining.
+// Panics if src is nil, src.Len() != Cols(), r < 0, or r >= Rows().
+func (g *Grid) SetRow(r int, src *Bitmap) *Grid {
+	if err := g.validateRow(r); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SetRow"))
+	}
+	if err := validateLineLength(src, g.cols); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SetRow"))
+	}
+	g.setRow(r, src)
+	return g
+}
+
+// SetCol overwrites column c with src: cell (r,c) becomes src bit r.
+// src is not modified. Returns *Grid for chaining.
+// Panics if src is nil, src.Len() != Rows(), c < 0, or c >= Cols().
+func (g *Grid) SetCol(c int, src *Bitmap) *Grid {
+	if err := g.validateCol(c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SetCol"))
+	}
+	if err := validateLineLength(src, g.rows); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SetCol"))
+	}
+	g.setCol(c, src)
+	return g
+}
+
+// ClearRow clears every cell in row r. No-op if Cols() == 0.
+// Returns *Grid for chaining. Panics if r < 0 or r >= Rows().
+func (g *Grid) ClearRow(r int) *Grid {
+	if err := g.validateRow(r); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ClearRow"))
+	}
+	g.clearRow(r)
+	return g
+}
+
+// ClearCol clears every cell in column c. No-op if Rows() == 0.
+// Returns *Grid for chaining. Panics if c < 0 or c >= Cols().
+func (g *Grid) ClearCol(c int) *Grid {
+	if err := g.validateCol(c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ClearCol"))
+	}
+	g.clearCol(c)
+	return g
+}
+
+// FillCol sets every cell in column c. No-op if Rows() == 0.
+// Returns *Grid for chaining. Panics if c < 0 or c >= Cols().
+func (g *Grid) FillCol(c int) *Grid {
+	if err := g.validateCol(c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.FillCol"))
+	}
+	g.fillCol(c)
+	return g
+}
+
+// CopyRow overwrites row dstR with a copy of row srcR; row srcR is unchanged.
+// No-op if srcR == dstR. Returns *Grid for chaining.
+// Panics if srcR or dstR is out of [0, Rows()).
+func (g *Grid) CopyRow(srcR, dstR int) *Grid {
+	if err := g.validateRow(srcR); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CopyRow"))
+	}
+	if err := g.validateRow(dstR); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CopyRow"))
+	}
+	g.copyRow(srcR, dstR)
+	return g
+}
+
+// SwapRows exchanges the cells of rows r1 and r2. No-op if r1 == r2.
+// Returns *Grid for chaining. Panics if r1 or r2 is out of [0, Rows()).
+func (g *Grid) SwapRows(r1, r2 int) *Grid {
+	if err := g.validateRow(r1); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SwapRows"))
+	}
+	if err := g.validateRow(r2); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SwapRows"))
+	}
+	g.swapRows(r1, r2)
+	return g
+}
+
+// SwapCols exchanges the cells of columns c1 and c2. No-op if c1 == c2.
+// Returns *Grid for chaining. Panics if c1 or c2 is out of [0, Cols()).
+func (g *Grid) SwapCols(c1, c2 int) *Grid {
+	if err := g.validateCol(c1); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SwapCols"))
+	}
+	if err := g.validateCol(c2); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.SwapCols"))
+	}
+	g.swapCols(c1, c2)
+	return g
+}
+
 // ========================================
 // Rectangle Mutators
 // ========================================
@@ -308,90 +851,375 @@ func (g *Grid) ClearRect(r, c, h, w int) *Grid {
 	return g
 }
 
+// ToggleRect flips every cell of a rectangle of size h×w at origin (r,c).
+// Panics if rectangle exceeds current Rows() or Cols(). Returns g.
+func (g *Grid) ToggleRect(r, c, h, w int) *Grid {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ToggleRect"))
+	}
+	g.toggleRect(r, c, h, w)
+	return g
+}
+
+// CopyRect copies the h×w block of cells from src at (srcR,srcC) into g at
+// (dstR,dstC). Cells outside the destination block are untouched and src is
+// not modified. src may be g; overlapping rectangles are handled with
+// memmove semantics. Returns *Grid for chaining.
+// Panics if src is nil or either rectangle is invalid or out of bounds.
+func (g *Grid) CopyRect(src *Grid, srcR, srcC, h, w, dstR, dstC int) *Grid {
+	if err := validateNotNil(src, "src"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CopyRect"))
+	}
+	if err := src.validateRect(srcR, srcC, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CopyRect"))
+	}
+	if err := g.validateRect(dstR, dstC, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CopyRect"))
+	}
+	g.copyRect(src, srcR, srcC, h, w, dstR, dstC)
+	return g
+}
+
+// MoveRect moves the h×w rectangle at (r,c) to (dstR,dstC), preserving its
+// cell pattern. Source cells not covered by the destination are cleared.
+// The destination must be in bounds and free (all zeros) except where it
+// overlaps the source. Returns *Grid for chaining. Panics if either rectangle
+// is invalid or out of bounds, or the destination is not free.
+func (g *Grid) MoveRect(r, c, h, w, dstR, dstC int) *Grid {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.MoveRect"))
+	}
+	if err := g.validateRect(dstR, dstC, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.MoveRect"))
+	}
+	if !g.rectZeroExcept(dstR, dstC, h, w, r, c) {
+		panic(&ValidationError{
+			Field:   "destination",
+			Value:   fmt.Sprintf("dstR=%d, dstC=%d", dstR, dstC),
+			Message: "target rectangle not free",
+			Err:     ErrInvalidArgument,
+			Context: "Grid.MoveRect",
+		})
+	}
+	g.moveRect(r, c, h, w, dstR, dstC)
+	return g
+}
+
+// PlaceRect finds the first free h×w slot like FindFreeRect and sets it to 1.
+// Returns the slot origin, or ok=false with the grid unchanged if nothing fits.
+// Panics if h <= 0 or w <= 0.
+func (g *Grid) PlaceRect(h, w int) (r, c int, ok bool) {
+	if err := validatePositive(h, "h"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.PlaceRect"))
+	}
+	if err := validatePositive(w, "w"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.PlaceRect"))
+	}
+
+	r, c, ok = g.findFreeRect(h, w)
+	if ok {
+		g.setRect(r, c, h, w)
+	}
+	return r, c, ok
+}
+
+// OverlayAt ORs every cell of src onto g with src's top-left cell placed at
+// (dstR,dstC). Cells of g outside the overlay are untouched; src is not
+// modified. Returns *Grid for chaining. Panics if src is nil, dstR < 0,
+// dstC < 0, dstR+src.Rows() > Rows(), or dstC+src.Cols() > Cols().
+func (g *Grid) OverlayAt(dstR, dstC int, src *Grid) *Grid {
+	if err := g.validateOverlay(dstR, dstC, src); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.OverlayAt"))
+	}
+	g.overlayAt(dstR, dstC, src)
+	return g
+}
+
 // ShiftRectRight shifts a rectangle one column to the right.
 // Moves bits from [r,c,h,w) to [r,c+1,h,w) and clears the leftmost column.
 // Target column (c+w) must exist and be free (all zeros).
 // Returns *Grid for chaining. Panics if rectangle is invalid, out of bounds,
-// or target column is not free.
+// or target column does not exist or is not free.
 func (g *Grid) ShiftRectRight(r, c, h, w int) *Grid {
 	if err := g.validateRect(r, c, h, w); err != nil {
 		panic(err.(*ValidationError).WithContext("Grid.ShiftRectRight"))
 	}
-	if !g.rectZero(r, c+w, h, 1) {
-		panic(&ValidationError{
-			Field:   "shift",
-			Value:   "right",
-			Message: "target column not free",
-			Context: "Grid.ShiftRectRight",
-		})
+	if err := g.validateShiftTarget(r, c, h, w, 1, shiftRight); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectRight"))
 	}
 	g.shiftRectRight(r, c, h, w)
 	return g
 }
 
+// ShiftRectRightBy shifts a rectangle n columns to the right in one step.
+// Moves bits from [r,c,h,w) to [r,c+n,h,w); vacated columns are cleared.
+// Target columns [c+w, c+w+n) must exist and be free (all zeros).
+// Returns *Grid for chaining. Panics if rectangle is invalid, n <= 0, or
+// any target column does not exist or is not free.
+func (g *Grid) ShiftRectRightBy(r, c, h, w, n int) *Grid {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectRightBy"))
+	}
+	if err := validatePositive(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectRightBy"))
+	}
+	if err := g.validateShiftTarget(r, c, h, w, n, shiftRight); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectRightBy"))
+	}
+	g.shiftRectRightBy(r, c, h, w, n)
+	return g
+}
+
 // ShiftRectLeft shifts a rectangle one column to the left.
 // Moves bits from [r,c,h,w) to [r,c-1,h,w) and clears the rightmost column.
 // Target column (c-1) must exist and be free (all zeros).
 // Returns *Grid for chaining. Panics if rectangle is invalid, out of bounds,
-// or target column is not free.
+// or target column does not exist or is not free.
 func (g *Grid) ShiftRectLeft(r, c, h, w int) *Grid {
 	if err := g.validateRect(r, c, h, w); err != nil {
 		panic(err.(*ValidationError).WithContext("Grid.ShiftRectLeft"))
 	}
-	if !g.rectZero(r, c-1, h, 1) {
-		panic(&ValidationError{
-			Field:   "shift",
-			Value:   "left",
-			Message: "target column not free",
-			Context: "Grid.ShiftRectLeft",
-		})
+	if err := g.validateShiftTarget(r, c, h, w, 1, shiftLeft); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectLeft"))
 	}
 	g.shiftRectLeft(r, c, h, w)
 	return g
 }
 
+// ShiftRectLeftBy shifts a rectangle n columns to the left in one step.
+// Moves bits from [r,c,h,w) to [r,c-n,h,w); vacated columns are cleared.
+// Target columns [c-n, c) must exist and be free (all zeros).
+// Returns *Grid for chaining. Panics if rectangle is invalid, n <= 0, or
+// any target column does not exist or is not free.
+func (g *Grid) ShiftRectLeftBy(r, c, h, w, n int) *Grid {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectLeftBy"))
+	}
+	if err := validatePositive(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectLeftBy"))
+	}
+	if err := g.validateShiftTarget(r, c, h, w, n, shiftLeft); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectLeftBy"))
+	}
+	g.shiftRectLeftBy(r, c, h, w, n)
+	return g
+}
+
 // ShiftRectUp shifts a rectangle one row up.
 // Moves bits from [r,c,h,w) to [r-1,c,h,w) and clears the bottom row.
 // Target row (r-1) must exist and be free (all zeros).
 // Returns *Grid for chaining. Panics if rectangle is invalid, out of bounds,
-// or target row is not free.
+// or target row does not exist or is not free.
 func (g *Grid) ShiftRectUp(r, c, h, w int) *Grid {
 	if err := g.validateRect(r, c, h, w); err != nil {
 		panic(err.(*ValidationError).WithContext("Grid.ShiftRectUp"))
 	}
-	if !g.rectZero(r-1, c, 1, w) {
-		panic(&ValidationError{
-			Field:   "shift",
-			Value:   "up",
-			Message: "target row not free",
-			Context: "Grid.ShiftRectUp",
-		})
+	if err := g.validateShiftTarget(r, c, h, w, 1, shiftUp); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectUp"))
 	}
 	g.shiftRectUp(r, c, h, w)
 	return g
 }
 
+// ShiftRectUpBy shifts a rectangle n rows up in one step.
+// Moves bits from [r,c,h,w) to [r-n,c,h,w); vacated rows are cleared.
+// Target rows [r-n, r) must exist and be free (all zeros).
+// Returns *Grid for chaining. Panics if rectangle is invalid, n <= 0, or
+// any target row does not exist or is not free.
+func (g *Grid) ShiftRectUpBy(r, c, h, w, n int) *Grid {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectUpBy"))
+	}
+	if err := validatePositive(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectUpBy"))
+	}
+	if err := g.validateShiftTarget(r, c, h, w, n, shiftUp); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectUpBy"))
+	}
+	g.shiftRectUpBy(r, c, h, w, n)
+	return g
+}
+
 // ShiftRectDown shifts a rectangle one row down.
 // Moves bits from [r,c,h,w) to [r+1,c,h,w) and clears the top row.
 // Target row (r+h) must exist and be free (all zeros).
 // Returns *Grid for chaining. Panics if rectangle is invalid, out of bounds,
-// or target row is not free.
+// or target row does not exist or is not free.
 func (g *Grid) ShiftRectDown(r, c, h, w int) *Grid {
 	if err := g.validateRect(r, c, h, w); err != nil {
 		panic(err.(*ValidationError).WithContext("Grid.ShiftRectDown"))
 	}
-	if !g.rectZero(r+h, c, 1, w) {
-		panic(&ValidationError{
-			Field:   "shift",
-			Value:   "down",
-			Message: "target row not free",
-			Context: "Grid.ShiftRectDown",
-		})
+	if err := g.validateShiftTarget(r, c, h, w, 1, shiftDown); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectDown"))
 	}
 	g.shiftRectDown(r, c, h, w)
 	return g
 }
 
+// ShiftRectDownBy shifts a rectangle n rows down in one step.
+// Moves bits from [r,c,h,w) to [r+n,c,h,w); vacated rows are cleared.
+// Target rows [r+h, r+h+n) must exist and be free (all zeros).
+// Returns *Grid for chaining. Panics if rectangle is invalid, n <= 0, or
+// any target row does not exist or is not free.
+func (g *Grid) ShiftRectDownBy(r, c, h, w, n int) *Grid {
+	if err := g.validateRect(r, c, h, w); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectDownBy"))
+	}
+	if err := validatePositive(n, "n"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectDownBy"))
+	}
+	if err := g.validateShiftTarget(r, c, h, w, n, shiftDown); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ShiftRectDownBy"))
+	}
+	g.shiftRectDownBy(r, c, h, w, n)
+	return g
+}
+
+// ========================================
+// Region Operations
+// ========================================
+
+// MaxEmptyRectangle returns the origin (r,c) and size h×w of a maximum-area
+// rectangle containing only zero cells. Ties keep the rectangle whose bottom
+// row is highest, then leftmost. Returns all zeros if no zero cell exists.
+// Runs in O(Rows()*Cols()).
+func (g *Grid) MaxEmptyRectangle() (r, c, h, w int) {
+	return g.maxEmptyRectangle()
+}
+
+// LargestEmptySquare returns the origin (r,c) and side length of the largest
+// square containing only zero cells. Ties break toward the top-left.
+// Returns size 0 if no zero cell exists. Runs in O(Rows()*Cols()) time
+// with O(Cols()) extra space.
+func (g *Grid) LargestEmptySquare() (r, c, size int) {
+	return g.largestEmptySquare()
+}
+
+// ConnectedComponents groups set cells into connected components, using
+// 8-connectivity when diagonal is true and 4-connectivity otherwise.
+// Each component lists its cell indexes (r*Cols()+c, see Index) in ascending
+// order, and components are ordered by their first cell in row-major order.
+// Returns nil if no cell is set.
+func (g *Grid) ConnectedComponents(diagonal bool) [][]int {
+	return g.connectedComponents(diagonal)
+}
+
+// FloodFill sets every cell 4-connected to (r,c) that shares the seed cell's
+// current value to value. The region is bounded by cells of the opposite
+// value and the grid edges. No-op if the seed already equals value.
+// Returns *Grid for chaining. Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) FloodFill(r, c int, value bool) *Grid {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.FloodFill"))
+	}
+	g.floodFill(r, c, value)
+	return g
+}
+
+// BoundingBox returns the smallest rectangle (r,c,h,w) containing every set
+// cell. Returns ok=false if no cell is set. Runs in O(h) range searches over
+// the spanned rows rather than testing every cell.
+func (g *Grid) BoundingBox() (r, c, h, w int, ok bool) {
+	return g.boundingBox()
+}
+
+// CountNeighbors returns the number of set cells among the 8 neighbors of
+// (r,c) when diagonal is true, or the 4 orthogonal neighbors otherwise.
+// Neighbors outside the grid count as unset; (r,c) itself is not counted.
+// Panics if r < 0, c < 0, r >= Rows(), or c >= Cols().
+func (g *Grid) CountNeighbors(r, c int, diagonal bool) int {
+	if err := g.validateCoordinate(r, c); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.CountNeighbors"))
+	}
+	return g.countNeighbors(r, c, diagonal)
+}
+
+// Dilate returns a new grid in which a cell is set if it or any of its
+// neighbors is set in g: the 8 surrounding cells when diagonal is true,
+// otherwise the 4 orthogonal ones. g is not modified.
+func (g *Grid) Dilate(diagonal bool) *Grid {
+	return g.dilate(diagonal)
+}
+
+// Erode returns a new grid in which a cell stays set only if it and all of
+// its neighbors are set in g: the 8 surrounding cells when diagonal is true,
+// otherwise the 4 orthogonal ones. Neighbors outside the grid count as
+// unset, so cells on the border are always cleared. g is not modified.
+func (g *Grid) Erode(diagonal bool) *Grid {
+	return g.erode(diagonal)
+}
+
+// ========================================
+// Transform Operations
+// ========================================
+
+// Transpose returns a new grid with Rows() and Cols() swapped, where result
+// cell (c,r) equals g's cell (r,c). g is not modified.
+func (g *Grid) Transpose() *Grid {
+	return g.transpose()
+}
+
+// FlipHorizontal mirrors g in place by reversing the column order within
+// each row. No-op for empty grids. Returns *Grid for chaining.
+func (g *Grid) FlipHorizontal() *Grid {
+	g.flipHorizontal()
+	return g
+}
+
+// FlipVertical mirrors g in place by reversing the row order.
+// No-op for empty grids. Returns *Grid for chaining.
+func (g *Grid) FlipVertical() *Grid {
+	g.flipVertical()
+	return g
+}
+
+// Rotate90 returns a new Cols()×Rows() grid rotated a quarter turn
+// clockwise: cell (r,c) maps to (c, Rows()-1-r). g is not modified.
+func (g *Grid) Rotate90() *Grid {
+	return g.rotate90()
+}
+
+// Rotate180 returns a new grid rotated a half turn:
+// cell (r,c) maps to (Rows()-1-r, Cols()-1-c). g is not modified.
+func (g *Grid) Rotate180() *Grid {
+	return g.rotate180()
+}
+
+// Rotate270 returns a new Cols()×Rows() grid rotated a quarter turn
+// counter-clockwise: cell (r,c) maps to (Cols()-1-c, r). g is not modified.
+func (g *Grid) Rotate270() *Grid {
+	return g.rotate270()
+}
+
+// ========================================
+// Encoding Operations
+// ========================================
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The encoding is an 8-byte little-endian Rows() header and an 8-byte
+// little-endian Cols() header followed by the Bitmap.MarshalBinary encoding
+// of B.
+func (g *Grid) MarshalBinary() ([]byte, error) {
+	return g.marshalBinary(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// Replaces B, Rows(), and Cols() with the decoded grid. Returns
+// ValidationError on truncated or inconsistent input, including a bitmap
+// length that differs from Rows()*Cols(); g is left unchanged on error.
+func (g *Grid) UnmarshalBinary(data []byte) error {
+	if err := g.unmarshalBinary(data); err != nil {
+		return err.(*ValidationError).WithContext("Grid.UnmarshalBinary")
+	}
+	return nil
+}
+
+// ToBools returns the cells as a new Rows()×Cols() matrix where m[r][c]
+// reports whether cell (r,c) is set. Each row has length and capacity Cols().
+func (g *Grid) ToBools() [][]bool {
+	return g.toBools()
+}
+
 // ========================================
 // Print Operations
 // ========================================
@@ -410,3 +1238,36 @@ func (g *Grid) ShiftRectDown(r, c, h, w int) *Grid {
 func (g *Grid) Print() string {
 	return g.print()
 }
+
+// PrintWith formats the grid like Print with configurable glyphs, cell
+// separator, and labels. PrintWith(DefaultGridPrintOptions()) equals Print().
+// Returns empty string if grid has no rows or columns.
+//
+// Example output for the Print example grid with Set '█', Clear '░',
+// Sep "", and Labels false:
+//
+//	░█░░░
+//	░░░█░
+//	░░░░░
+func (g *Grid) PrintWith(opts GridPrintOptions) string {
+	return g.printWith(opts)
+}
+
+// String implements fmt.Stringer, returning the same visualization as Print().
+// Grids with no rows or columns return a marker such as "Grid(0x0)" instead
+// of an empty string.
+func (g *Grid) String() string {
+	return g.string()
+}
+
+// ToImage renders the grid as an image in which each cell is a
+// cellSize×cellSize block of setColor or clearColor, with cell (r,c) at
+// pixel (c*cellSize, r*cellSize). Grids with no rows or columns yield a
+// zero-sized image. Encode the result with image/png or similar.
+// Panics if cellSize <= 0.
+func (g *Grid) ToImage(cellSize int, setColor, clearColor color.Color) image.Image {
+	if err := validatePositive(cellSize, "cellSize"); err != nil {
+		panic(err.(*ValidationError).WithContext("Grid.ToImage"))
+	}
+	return g.toImage(cellSize, setColor, clearColor)
+}
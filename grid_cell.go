@@ -0,0 +1,25 @@
+package btmp
+
+// at reports whether cell (r,c) is set.
+// Internal implementation - no validation.
+func (g *Grid) at(r, c int) bool {
+	return g.B.test(g.rowStart(r) + c)
+}
+
+// setCell sets cell (r,c) to 1.
+// Internal implementation - no validation.
+func (g *Grid) setCell(r, c int) {
+	g.B.setBit(g.rowStart(r) + c)
+}
+
+// clearCell sets cell (r,c) to 0.
+// Internal implementation - no validation.
+func (g *Grid) clearCell(r, c int) {
+	g.B.clearBit(g.rowStart(r) + c)
+}
+
+// toggleCell flips cell (r,c).
+// Internal implementation - no validation.
+func (g *Grid) toggleCell(r, c int) {
+	g.B.flipBit(g.rowStart(r) + c)
+}
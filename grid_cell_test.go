@@ -0,0 +1,88 @@
+package btmp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestGridCellAccessors validates Grid.At(), SetCell(), and ClearCell().
+func TestGridCellAccessors(t *testing.T) {
+	t.Run("set and clear", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 70)
+		g.SetCell(2, 69).SetCell(1, 0).SetCell(0, 64)
+		for _, p := range [][2]int{{2, 69}, {1, 0}, {0, 64}} {
+			if !g.At(p[0], p[1]) {
+				t.Errorf("expected (%d,%d) set", p[0], p[1])
+			}
+		}
+		g.ClearCell(1, 0)
+		if g.At(1, 0) {
+			t.Error("expected (1,0) clear")
+		}
+		if got := g.B.Count(); got != 2 {
+			t.Errorf("expected 2 set cells, got %d", got)
+		}
+	})
+
+	t.Run("matches Index", func(t *testing.T) {
+		g := sampleGrid(4, 9)
+		for r := range g.Rows() {
+			for c := range g.Cols() {
+				if got, want := g.At(r, c), g.B.Test(g.Index(r, c)); got != want {
+					t.Errorf("(%d,%d): expected %v, got %v", r, c, want, got)
+				}
+			}
+		}
+	})
+
+	t.Run("rejects upper bounds", func(t *testing.T) {
+		g := btmp.NewGridWithSize(2, 3)
+		tests := []struct {
+			name string
+			fn   func()
+		}{
+			{"At column", func() { g.At(0, 3) }},
+			{"At row", func() { g.At(2, 0) }},
+			{"SetCell", func() { g.SetCell(0, 3) }},
+			{"ClearCell", func() { g.ClearCell(-1, 0) }},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				defer func() {
+					err, _ := recover().(error)
+					if !errors.Is(err, btmp.ErrOutOfBounds) && !errors.Is(err, btmp.ErrNegative) {
+						t.Errorf("expected bounds panic, got %v", err)
+					}
+				}()
+				tt.fn()
+			})
+		}
+		if g.B.Any() {
+			t.Error("expected grid unchanged")
+		}
+	})
+}
+
+// TestGridToggleCell validates Grid.ToggleCell().
+func TestGridToggleCell(t *testing.T) {
+	g := btmp.NewGridWithSize(2, 70)
+	g.ToggleCell(1, 65)
+	if !g.At(1, 65) {
+		t.Error("expected (1,65) set after first toggle")
+	}
+	g.ToggleCell(1, 65)
+	if g.At(1, 65) {
+		t.Error("expected (1,65) clear after second toggle")
+	}
+
+	t.Run("panics on out-of-bounds cell", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds cell")
+			}
+		}()
+		g.ToggleCell(0, 70)
+	})
+}
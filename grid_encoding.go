@@ -0,0 +1,113 @@
+package btmp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// gridHeaderSize is the size of the rows and cols header in the grid binary
+// format.
+const gridHeaderSize = 16
+
+// Grid binary format (all values little-endian):
+//
+//	[0:8)   uint64 Rows()
+//	[8:16)  uint64 Cols()
+//	[16:..) the Bitmap binary format of B, whose length must equal Rows()*Cols()
+
+// toBools returns the cells as a Rows()×Cols() matrix. All rows share one
+// backing array but are capped at Cols() so appends cannot overlap.
+// Internal implementation - no validation.
+func (g *Grid) toBools() [][]bool {
+	m := make([][]bool, g.rows)
+	cells := make([]bool, g.rows*g.cols)
+	for r := range g.rows {
+		start := g.rowStart(r)
+		m[r] = cells[start : start+g.cols : start+g.cols]
+	}
+	for i := range g.B.bitsSeq(true) {
+		cells[i] = true
+	}
+	return m
+}
+
+// gridFromBools builds a grid shaped like m with cell (r,c) set where
+// m[r][c] is true.
+// Internal implementation - no validation, m must be rectangular.
+func gridFromBools(m [][]bool) *Grid {
+	rows, cols := len(m), 0
+	if rows > 0 {
+		cols = len(m[0])
+	}
+	g := &Grid{
+		B:    New(uint(rows * cols)),
+		cols: cols,
+		rows: rows,
+	}
+	for r, row := range m {
+		start := g.rowStart(r)
+		for c, v := range row {
+			if v {
+				g.B.setBit(start + c)
+			}
+		}
+	}
+	return g
+}
+
+// marshalBinary encodes the grid into the grid binary format.
+// Internal implementation - no validation.
+func (g *Grid) marshalBinary() []byte {
+	body := g.B.marshalBinary()
+	buf := make([]byte, gridHeaderSize, gridHeaderSize+len(body))
+	binary.LittleEndian.PutUint64(buf, uint64(g.rows))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(g.cols))
+	return append(buf, body...)
+}
+
+// unmarshalBinary decodes the grid binary format into g, replacing B, Rows(),
+// and Cols(). Returns ValidationError on truncated or inconsistent input,
+// including a bitmap length other than Rows()*Cols(); g is left unchanged on
+// error.
+func (g *Grid) unmarshalBinary(data []byte) error {
+	if len(data) < gridHeaderSize {
+		return &ValidationError{
+			Field:   "data",
+			Value:   fmt.Sprintf("len=%d", len(data)),
+			Message: "truncated header",
+			Err:     ErrInvalidData,
+		}
+	}
+	rows := binary.LittleEndian.Uint64(data)
+	cols := binary.LittleEndian.Uint64(data[8:])
+	if rows > math.MaxInt || cols > math.MaxInt {
+		return &ValidationError{
+			Field:   "size",
+			Value:   fmt.Sprintf("rows=%d, cols=%d", rows, cols),
+			Message: "overflow",
+			Err:     ErrOverflow,
+		}
+	}
+	if err := validateGridSizeMax(int(rows), int(cols)); err != nil {
+		return err
+	}
+
+	b := &Bitmap{}
+	if err := b.unmarshalBinary(data[gridHeaderSize:]); err != nil {
+		return err
+	}
+	if b.lenBits != int(rows)*int(cols) {
+		return &ValidationError{
+			Field:   "data",
+			Value:   fmt.Sprintf("rows=%d, cols=%d, len=%d", rows, cols, b.lenBits),
+			Message: "bitmap length does not match rows*cols",
+			Err:     ErrInvalidData,
+		}
+	}
+
+	g.B = b
+	g.rows = int(rows)
+	g.cols = int(cols)
+	return nil
+}
@@ -0,0 +1,153 @@
+package btmp_test
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*btmp.Grid)(nil)
+	_ encoding.BinaryUnmarshaler = (*btmp.Grid)(nil)
+)
+
+// TestGridBinary validates Grid.MarshalBinary() and Grid.UnmarshalBinary().
+func TestGridBinary(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		tests := []struct {
+			name string
+			g    *btmp.Grid
+		}{
+			{"sample", sampleGrid(5, 70)},
+			{"zero rows", btmp.NewGridWithSize(0, 7)},
+			{"zero cols", btmp.NewGridWithSize(4, 0)},
+			{"empty", btmp.NewGrid()},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				data, err := tt.g.MarshalBinary()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got := btmp.NewGrid()
+				if err := got.UnmarshalBinary(data); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.Rows() != tt.g.Rows() || got.Cols() != tt.g.Cols() {
+					t.Fatalf("expected %dx%d, got %dx%d", tt.g.Rows(), tt.g.Cols(), got.Rows(), got.Cols())
+				}
+				if !got.Equal(tt.g) {
+					t.Errorf("expected cells to round trip")
+				}
+			})
+		}
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		data, _ := sampleGrid(3, 5).MarshalBinary()
+		mismatch := append([]byte(nil), data...)
+		binary.LittleEndian.PutUint64(mismatch, 4)
+		tests := []struct {
+			name string
+			data []byte
+		}{
+			{"truncated header", data[:10]},
+			{"truncated bitmap", data[:len(data)-1]},
+			{"dimension mismatch", mismatch},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := sampleGrid(2, 2)
+				if err := g.UnmarshalBinary(tt.data); err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if g.Rows() != 2 || g.Cols() != 2 || !g.Equal(sampleGrid(2, 2)) {
+					t.Errorf("expected grid unchanged on error")
+				}
+			})
+		}
+	})
+
+	t.Run("rejects wrapped size product", func(t *testing.T) {
+		// rows=2^61 and cols=0x3030303030303030 multiply to 0 mod 2^64,
+		// matching the empty bitmap body that follows.
+		data := []byte("\x00\x00\x00\x00\x00\x00\x00 00000000")
+		data = append(data, make([]byte, 8)...)
+		g := sampleGrid(2, 2)
+		err := g.UnmarshalBinary(data)
+		if !errors.Is(err, btmp.ErrOverflow) {
+			t.Fatalf("expected ErrOverflow, got %v", err)
+		}
+		if g.Rows() != 2 || g.Cols() != 2 || !g.Equal(sampleGrid(2, 2)) {
+			t.Errorf("expected grid unchanged on error")
+		}
+	})
+}
+
+// TestGridBools validates Grid.ToBools() and GridFromBools().
+func TestGridBools(t *testing.T) {
+	t.Run("ToBools reflects cells", func(t *testing.T) {
+		g := sampleGrid(3, 70)
+		m := g.ToBools()
+		if len(m) != 3 {
+			t.Fatalf("expected 3 rows, got %d", len(m))
+		}
+		for r, row := range m {
+			if len(row) != 70 || cap(row) != 70 {
+				t.Fatalf("row %d: expected len=cap=70, got len=%d cap=%d", r, len(row), cap(row))
+			}
+			for c, v := range row {
+				if want := g.B.Test(g.Index(r, c)); v != want {
+					t.Errorf("(%d,%d): expected %v, got %v", r, c, want, v)
+				}
+			}
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		g := sampleGrid(4, 9)
+		got := btmp.GridFromBools(g.ToBools())
+		if !got.Equal(g) {
+			t.Errorf("expected round trip to equal source:\n%s\ngot:\n%s", g.Print(), got.Print())
+		}
+	})
+
+	t.Run("FromBools infers shape", func(t *testing.T) {
+		g := btmp.GridFromBools([][]bool{
+			{true, false, false},
+			{false, false, true},
+		})
+		if g.Rows() != 2 || g.Cols() != 3 {
+			t.Fatalf("expected 2x3, got %dx%d", g.Rows(), g.Cols())
+		}
+		if g.B.Count() != 2 || !g.B.Test(g.Index(0, 0)) || !g.B.Test(g.Index(1, 2)) {
+			t.Errorf("expected cells (0,0) and (1,2) set, got %s", g.Print())
+		}
+	})
+
+	t.Run("empty matrix", func(t *testing.T) {
+		g := btmp.GridFromBools(nil)
+		if g.Rows() != 0 || g.Cols() != 0 {
+			t.Errorf("expected 0x0, got %dx%d", g.Rows(), g.Cols())
+		}
+		g = btmp.GridFromBools([][]bool{{}, {}})
+		if g.Rows() != 2 || g.Cols() != 0 {
+			t.Errorf("expected 2x0, got %dx%d", g.Rows(), g.Cols())
+		}
+		if m := g.ToBools(); len(m) != 2 || len(m[0]) != 0 {
+			t.Errorf("expected 2 empty rows, got %v", m)
+		}
+	})
+
+	t.Run("panics on ragged input", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for ragged matrix")
+			}
+		}()
+		btmp.GridFromBools([][]bool{{true, false}, {true}})
+	})
+}
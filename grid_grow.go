@@ -75,3 +75,79 @@ func (g *Grid) growRows(delta int) {
 	g.B.EnsureBits(newRows * g.cols)
 	g.rows = newRows
 }
+
+// insertRow inserts an empty row at index at, shifting rows [at, Rows()) down.
+// Internal implementation - no validation.
+func (g *Grid) insertRow(at int) {
+	g.B.EnsureBits((g.rows + 1) * g.cols)
+	// MoveRange clears the vacated source row at index at
+	g.B.MoveRange(g.rowStart(at), g.rowStart(at+1), (g.rows-at)*g.cols)
+	g.rows++
+}
+
+// removeRow deletes row at, shifting rows below it up and shrinking Rows().
+// Internal implementation - no validation.
+func (g *Grid) removeRow(at int) {
+	g.B.MoveRange(g.rowStart(at+1), g.rowStart(at), (g.rows-at-1)*g.cols)
+	g.rows--
+	// Truncate clears the now-unused last row
+	g.B.Truncate(g.rows * g.cols)
+}
+
+// insertCol inserts an empty column at index at, shifting columns [at, Cols())
+// right by one. Like growCols, rows are repositioned bottom-to-top, and within
+// a row the right part moves before the left so no unread source is overwritten.
+// Internal implementation - no validation.
+func (g *Grid) insertCol(at int) {
+	oldCols := g.cols
+	newCols := oldCols + 1
+
+	g.B.EnsureBits(g.rows * newCols)
+	for r := g.rows - 1; r >= 0; r-- {
+		src := r * oldCols
+		dst := r * newCols
+		// MoveRange clears vacated source bits, leaving the new column zero
+		g.B.MoveRange(src+at, dst+at+1, oldCols-at)
+		g.B.MoveRange(src, dst, at)
+	}
+	g.cols = newCols
+}
+
+// removeCol deletes column at, compacting each row to the smaller stride.
+// Rows are repositioned top-to-bottom since every row moves toward lower indexes.
+// Internal implementation - no validation.
+func (g *Grid) removeCol(at int) {
+	oldCols := g.cols
+	newCols := oldCols - 1
+
+	for r := range g.rows {
+		src := r * oldCols
+		dst := r * newCols
+		g.B.MoveRange(src, dst, at)
+		g.B.MoveRange(src+at+1, dst+at, oldCols-at-1)
+	}
+	g.cols = newCols
+	// Truncate clears the tail left behind by the compaction
+	g.B.Truncate(g.rows * newCols)
+}
+
+// shrinkRows removes the bottom delta rows, clearing their bits.
+// Internal implementation - no validation.
+func (g *Grid) shrinkRows(delta int) {
+	g.rows -= delta
+	g.B.Truncate(g.rows * g.cols)
+}
+
+// shrinkCols removes the rightmost delta columns, repositioning each row to
+// the smaller stride top-to-bottom and clearing the discarded tail.
+// Internal implementation - no validation.
+func (g *Grid) shrinkCols(delta int) {
+	oldCols := g.cols
+	newCols := oldCols - delta
+
+	for r := range g.rows {
+		g.B.MoveRange(r*oldCols, r*newCols, newCols)
+	}
+	g.cols = newCols
+	g.B.Truncate(g.rows * newCols)
+}
@@ -210,3 +210,200 @@ func TestGridEnsureCols(t *testing.T) {
 		}
 	})
 }
+
+// TestGridInsertRemoveRow validates Grid.InsertRow() and Grid.RemoveRow().
+func TestGridInsertRemoveRow(t *testing.T) {
+	for _, at := range []int{0, 2, 4} {
+		src := sampleGrid(4, 70)
+		g := src.Clone().InsertRow(at)
+
+		if g.Rows() != 5 || g.Cols() != 70 || g.B.Len() != 350 {
+			t.Fatalf("InsertRow(%d): expected 5x70 len=350, got %dx%d len=%d", at, g.Rows(), g.Cols(), g.B.Len())
+		}
+		if g.CountRow(at) != 0 {
+			t.Errorf("InsertRow(%d): expected empty inserted row", at)
+		}
+		for r := range 4 {
+			dst := r
+			if r >= at {
+				dst++
+			}
+			if !g.Row(dst).Equal(src.Row(r)) {
+				t.Errorf("InsertRow(%d): expected row %d at %d", at, r, dst)
+			}
+		}
+
+		g.RemoveRow(at)
+		if !g.Equal(src) {
+			t.Errorf("RemoveRow(%d): expected original grid restored", at)
+		}
+	}
+
+	t.Run("remove clears discarded bits", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 3).SetRect(0, 0, 3, 3).RemoveRow(0)
+		if g.Rows() != 2 || g.B.Count() != 6 {
+			t.Errorf("expected 2 full rows, got rows=%d count=%d", g.Rows(), g.B.Count())
+		}
+		g.GrowRows(1)
+		if g.CountRow(2) != 0 {
+			t.Error("expected no phantom bits after regrow")
+		}
+	})
+
+	t.Run("works with zero columns", func(t *testing.T) {
+		g := btmp.NewGridWithSize(2, 0).InsertRow(1).RemoveRow(0)
+		if g.Rows() != 2 {
+			t.Errorf("expected rows=2, got %d", g.Rows())
+		}
+	})
+
+	t.Run("panics on insert beyond rows", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for at > Rows()")
+			}
+		}()
+		btmp.NewGridWithSize(2, 2).InsertRow(3)
+	})
+
+	t.Run("panics on remove at rows", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for at >= Rows()")
+			}
+		}()
+		btmp.NewGridWithSize(2, 2).RemoveRow(2)
+	})
+}
+
+// TestGridInsertRemoveCol validates Grid.InsertCol() and Grid.RemoveCol().
+func TestGridInsertRemoveCol(t *testing.T) {
+	for _, dim := range [][2]int{{4, 70}, {5, 3}, {3, 64}} {
+		for _, at := range []int{0, 1, dim[1] / 2, dim[1]} {
+			src := sampleGrid(dim[0], dim[1])
+			g := src.Clone().InsertCol(at)
+
+			if g.Cols() != dim[1]+1 || g.Rows() != dim[0] || g.B.Len() != dim[0]*(dim[1]+1) {
+				t.Fatalf("%dx%d InsertCol(%d): unexpected shape %dx%d len=%d", dim[0], dim[1], at, g.Rows(), g.Cols(), g.B.Len())
+			}
+			if g.AnyCol(at) {
+				t.Errorf("%dx%d InsertCol(%d): expected empty inserted column", dim[0], dim[1], at)
+			}
+			for c := range dim[1] {
+				dst := c
+				if c >= at {
+					dst++
+				}
+				if !g.Col(dst).Equal(src.Col(c)) {
+					t.Fatalf("%dx%d InsertCol(%d): expected column %d at %d", dim[0], dim[1], at, c, dst)
+				}
+			}
+			if g.B.Count() != src.B.Count() {
+				t.Errorf("%dx%d InsertCol(%d): expected count preserved", dim[0], dim[1], at)
+			}
+
+			g.RemoveCol(at)
+			if !g.Equal(src) {
+				t.Errorf("%dx%d RemoveCol(%d): expected original grid restored", dim[0], dim[1], at)
+			}
+		}
+	}
+
+	t.Run("remove drops column content", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 4).FillCol(1).RemoveCol(1)
+		if g.Cols() != 3 || g.B.Any() {
+			t.Errorf("expected empty 3x3 grid, got cols=%d count=%d", g.Cols(), g.B.Count())
+		}
+	})
+
+	t.Run("insert into zero columns", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 0).InsertCol(0)
+		if g.Cols() != 1 || g.B.Len() != 3 || g.B.Any() {
+			t.Errorf("expected empty 3x1 grid, got %dx%d", g.Rows(), g.Cols())
+		}
+	})
+
+	t.Run("panics on insert beyond cols", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for at > Cols()")
+			}
+		}()
+		btmp.NewGridWithSize(2, 2).InsertCol(3)
+	})
+
+	t.Run("panics on remove at cols", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for at >= Cols()")
+			}
+		}()
+		btmp.NewGridWithSize(2, 2).RemoveCol(2)
+	})
+}
+
+// TestGridShrink validates Grid.ShrinkRows() and Grid.ShrinkCols().
+func TestGridShrink(t *testing.T) {
+	t.Run("ShrinkRows drops bottom rows", func(t *testing.T) {
+		src := sampleGrid(5, 70)
+		g := src.Clone().ShrinkRows(2)
+		if g.Rows() != 3 || g.B.Len() != 210 {
+			t.Fatalf("expected 3x70 len=210, got %dx%d len=%d", g.Rows(), g.Cols(), g.B.Len())
+		}
+		for r := range 3 {
+			if !g.Row(r).Equal(src.Row(r)) {
+				t.Errorf("expected row %d preserved", r)
+			}
+		}
+		g.GrowRows(2)
+		if g.CountRect(3, 0, 2, 70) != 0 {
+			t.Error("expected no phantom bits after regrow")
+		}
+	})
+
+	t.Run("ShrinkCols drops rightmost columns", func(t *testing.T) {
+		src := sampleGrid(4, 70)
+		g := src.Clone().ShrinkCols(7)
+		if g.Cols() != 63 || g.B.Len() != 4*63 {
+			t.Fatalf("expected 4x63, got %dx%d len=%d", g.Rows(), g.Cols(), g.B.Len())
+		}
+		for c := range 63 {
+			if !g.Col(c).Equal(src.Col(c)) {
+				t.Fatalf("expected column %d preserved", c)
+			}
+		}
+		g.GrowCols(7)
+		if g.CountRect(0, 63, 4, 7) != 0 {
+			t.Error("expected no phantom bits after regrow")
+		}
+	})
+
+	t.Run("shrink to zero", func(t *testing.T) {
+		g := sampleGrid(3, 3).ShrinkCols(3)
+		if g.Cols() != 0 || g.B.Len() != 0 {
+			t.Errorf("expected 3x0, got %dx%d", g.Rows(), g.Cols())
+		}
+		g = sampleGrid(3, 3).ShrinkRows(3)
+		if g.Rows() != 0 || g.B.Len() != 0 {
+			t.Errorf("expected 0x3, got %dx%d", g.Rows(), g.Cols())
+		}
+	})
+
+	t.Run("panics when delta exceeds rows", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for delta > Rows()")
+			}
+		}()
+		btmp.NewGridWithSize(2, 2).ShrinkRows(3)
+	})
+
+	t.Run("panics on negative delta", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative delta")
+			}
+		}()
+		btmp.NewGridWithSize(2, 2).ShrinkCols(-1)
+	})
+}
@@ -0,0 +1,27 @@
+package btmp
+
+import (
+	"image"
+	"image/color"
+)
+
+// toImage renders each cell as a cellSize×cellSize block. The result is a
+// two-color paletted image: index 0 is clearColor, so only set cells are
+// painted.
+// Internal implementation - no validation.
+func (g *Grid) toImage(cellSize int, setColor, clearColor color.Color) *image.Paletted {
+	img := image.NewPaletted(
+		image.Rect(0, 0, g.cols*cellSize, g.rows*cellSize),
+		color.Palette{clearColor, setColor},
+	)
+	for r, c := range g.cells() {
+		y0, x0 := r*cellSize, c*cellSize
+		for y := y0; y < y0+cellSize; y++ {
+			row := img.Pix[img.PixOffset(x0, y):][:cellSize]
+			for i := range row {
+				row[i] = 1
+			}
+		}
+	}
+	return img
+}
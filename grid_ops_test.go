@@ -0,0 +1,75 @@
+package btmp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestGridLogical validates Grid.And(), Grid.Or(), and Grid.Xor().
+func TestGridLogical(t *testing.T) {
+	a := btmp.NewGridWithSize(3, 70).SetRect(0, 0, 3, 40)
+	b := btmp.NewGridWithSize(3, 70).SetRect(1, 30, 2, 40)
+
+	t.Run("And", func(t *testing.T) {
+		g := a.Clone().And(b)
+		if g.B.Count() != 20 || !g.AllRect(1, 30, 2, 10) {
+			t.Errorf("expected 2x10 intersection, got count=%d", g.B.Count())
+		}
+	})
+
+	t.Run("Or", func(t *testing.T) {
+		g := a.Clone().Or(b)
+		if g.B.Count() != 120+80-20 {
+			t.Errorf("expected count=180, got %d", g.B.Count())
+		}
+	})
+
+	t.Run("Xor", func(t *testing.T) {
+		g := a.Clone().Xor(b)
+		if g.B.Count() != 160 || g.AnyRect(1, 30, 2, 10) {
+			t.Errorf("expected symmetric difference, got count=%d", g.B.Count())
+		}
+	})
+
+	t.Run("operand unchanged", func(t *testing.T) {
+		before := b.Clone()
+		a.Clone().Or(b)
+		if !b.Equal(before) {
+			t.Error("expected other unchanged")
+		}
+	})
+
+	t.Run("panics on shape mismatch with equal length", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			var ve *btmp.ValidationError
+			if err, ok := r.(error); !ok || !errors.As(err, &ve) {
+				t.Errorf("expected ValidationError panic, got %v", r)
+			}
+		}()
+		btmp.NewGridWithSize(2, 6).And(btmp.NewGridWithSize(3, 4))
+	})
+}
+
+// TestGridNot validates Grid.Not() inversion.
+func TestGridNot(t *testing.T) {
+	t.Run("inverts every cell", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 70).SetRect(1, 10, 2, 5).Not()
+		if g.B.Count() != 210-10 || g.AnyRect(1, 10, 2, 5) {
+			t.Errorf("expected inverted grid, got count=%d", g.B.Count())
+		}
+		g.GrowRows(1)
+		if g.CountRow(3) != 0 {
+			t.Error("expected no bits beyond the grid after Not")
+		}
+	})
+
+	t.Run("empty grid", func(t *testing.T) {
+		g := btmp.NewGrid().Not()
+		if g.B.Len() != 0 {
+			t.Errorf("expected len=0, got %d", g.B.Len())
+		}
+	})
+}
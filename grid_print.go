@@ -2,12 +2,46 @@ package btmp
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
+// GridPrintOptions controls the layout produced by Grid.PrintWith.
+// Use DefaultGridPrintOptions for the Print layout and adjust from there.
+type GridPrintOptions struct {
+	// Set is the glyph drawn for set cells.
+	Set rune
+	// Clear is the glyph drawn for clear cells.
+	Clear rune
+	// Sep is inserted between adjacent cells of a row and between column
+	// labels.
+	Sep string
+	// Labels draws the column index header and a row index before each row.
+	// Labeled cells are right-aligned to the widest column index.
+	Labels bool
+}
+
+// DefaultGridPrintOptions returns the options used by Print: '#' for set
+// cells, '.' for clear cells, single-space separators, and labels.
+func DefaultGridPrintOptions() GridPrintOptions {
+	return GridPrintOptions{
+		Set:    '#',
+		Clear:  '.',
+		Sep:    " ",
+		Labels: true,
+	}
+}
+
 // print formats the grid as a coordinate-labeled visualization.
 // Internal implementation - no validation.
 func (g *Grid) print() string {
+	return g.printWith(DefaultGridPrintOptions())
+}
+
+// printWith formats the grid according to opts.
+// Internal implementation - no validation.
+func (g *Grid) printWith(opts GridPrintOptions) string {
 	rows := g.rows
 	cols := g.cols
 
@@ -16,35 +50,41 @@ func (g *Grid) print() string {
 		return ""
 	}
 
-	// Calculate widths for alignment
-	rowWidth := len(fmt.Sprintf("%d", rows-1))
-	colWidth := len(fmt.Sprintf("%d", cols-1))
-
-	// Each column needs colWidth + 1 space separator (except last)
-	cellWidth := colWidth + 1
+	// Calculate widths for alignment; unlabeled cells are not padded
+	rowWidth, colWidth := 0, 0
+	if opts.Labels {
+		rowWidth = len(fmt.Sprintf("%d", rows-1))
+		colWidth = len(fmt.Sprintf("%d", cols-1))
+	}
+	set := fmt.Sprintf("%*c", colWidth, opts.Set)
+	clear := fmt.Sprintf("%*c", colWidth, opts.Clear)
 
 	// Estimate capacity
-	headerLen := rowWidth + 1 + (cols * cellWidth)
-	lineLen := rowWidth + 1 + (cols * cellWidth) + 1 // +1 for newline
-	capacity := headerLen + (rows * lineLen)
+	cellLen := max(len(set), len(clear))
+	lineLen := cols*cellLen + (cols-1)*len(opts.Sep) + 1 // +1 for newline
+	if opts.Labels {
+		lineLen += rowWidth + 1
+	}
 	var builder strings.Builder
-	builder.Grow(capacity)
+	builder.Grow((rows + 1) * lineLen)
 
 	// Build column header
-	for range rowWidth {
-		builder.WriteByte(' ')
-	}
-	builder.WriteByte(' ')
-	for col := range cols {
-		// Right-align column number within colWidth
-		colStr := fmt.Sprintf("%*d", colWidth, col)
-		builder.WriteString(colStr)
-		// Space separator after each column except last
-		if col < cols-1 {
+	if opts.Labels {
+		for range rowWidth {
 			builder.WriteByte(' ')
 		}
+		builder.WriteByte(' ')
+		for col := range cols {
+			// Right-align column number within colWidth
+			colStr := fmt.Sprintf("%*d", colWidth, col)
+			builder.WriteString(colStr)
+			// Separator after each column except last
+			if col < cols-1 {
+				builder.WriteString(opts.Sep)
+			}
+		}
+		builder.WriteByte('\n')
 	}
-	builder.WriteByte('\n')
 
 	// Build grid by iterating through bitmap words
 	bitLen := g.B.Len()
@@ -60,7 +100,7 @@ func (g *Grid) print() string {
 			}
 
 			// Row index at start of each row
-			if col == 0 {
+			if col == 0 && opts.Labels {
 				rowStr := fmt.Sprintf("%*d", rowWidth, row)
 				builder.WriteString(rowStr)
 				builder.WriteByte(' ')
@@ -68,14 +108,14 @@ func (g *Grid) print() string {
 
 			// Right-align cell value within colWidth
 			if (word>>bitOff)&1 == 1 {
-				builder.WriteString(fmt.Sprintf("%*c", colWidth, '#'))
+				builder.WriteString(set)
 			} else {
-				builder.WriteString(fmt.Sprintf("%*c", colWidth, '.'))
+				builder.WriteString(clear)
 			}
 
-			// Space separator after each column except last
+			// Separator after each column except last
 			if col < cols-1 {
-				builder.WriteByte(' ')
+				builder.WriteString(opts.Sep)
 			}
 
 			bitIdx++
@@ -95,3 +135,126 @@ func (g *Grid) print() string {
 
 	return builder.String()
 }
+
+// string formats the grid like print, or a "Grid(RxC)" marker when the grid
+// has no cells.
+// Internal implementation - no validation.
+func (g *Grid) string() string {
+	if g.rows == 0 || g.cols == 0 {
+		return fmt.Sprintf("Grid(%dx%d)", g.rows, g.cols)
+	}
+	return g.print()
+}
+
+// parseGrid parses s as either the labeled Print layout or plain ASCII art.
+// Leading and trailing empty lines are ignored.
+// Internal implementation - no validation.
+func parseGrid(s string, setRune rune) (*Grid, error) {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return NewGrid(), nil
+	}
+
+	if cols, ok := parsePrintHeader(lines[0]); ok {
+		return parseLabeledGrid(lines[1:], cols, setRune)
+	}
+	return parsePlainGrid(lines, setRune)
+}
+
+// parsePrintHeader reports whether line is a Print column header, i.e. it
+// starts with a space followed by the column indices 0..cols-1.
+func parsePrintHeader(line string) (cols int, ok bool) {
+	if !strings.HasPrefix(line, " ") {
+		return 0, false
+	}
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return 0, false
+	}
+	for i, f := range fields {
+		if f != strconv.Itoa(i) {
+			return 0, false
+		}
+	}
+	return len(fields), true
+}
+
+// parseLabeledGrid parses the rows of the Print layout. Every row must start
+// with its row index and hold exactly cols single-rune cells.
+func parseLabeledGrid(lines []string, cols int, setRune rune) (*Grid, error) {
+	if err := validateGridSizeMax(len(lines), cols); err != nil {
+		return nil, err
+	}
+	g := &Grid{
+		B:    New(uint(len(lines) * cols)),
+		cols: cols,
+		rows: len(lines),
+	}
+	for r, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != strconv.Itoa(r) {
+			return nil, &ValidationError{
+				Field:   "s",
+				Value:   fmt.Sprintf("line %d: %q", r+1, line),
+				Message: "missing row label",
+				Err:     ErrInvalidData,
+			}
+		}
+		if len(fields)-1 != cols {
+			return nil, &ValidationError{
+				Field:   "s",
+				Value:   fmt.Sprintf("row %d has %d cells, cols=%d", r, len(fields)-1, cols),
+				Message: "non-rectangular input",
+				Err:     ErrInvalidData,
+			}
+		}
+		for c, f := range fields[1:] {
+			v, size := utf8.DecodeRuneInString(f)
+			if size != len(f) {
+				return nil, &ValidationError{
+					Field:   "s",
+					Value:   fmt.Sprintf("row %d col %d: %q", r, c, f),
+					Message: "cell must be a single rune",
+					Err:     ErrInvalidData,
+				}
+			}
+			if v == setRune {
+				g.B.setBit(g.rowStart(r) + c)
+			}
+		}
+	}
+	return g, nil
+}
+
+// parsePlainGrid parses one cell per rune, one row per line. Cols is the
+// longest line in runes; shorter lines are padded with clear cells.
+func parsePlainGrid(lines []string, setRune rune) (*Grid, error) {
+	cols := 0
+	for _, line := range lines {
+		cols = max(cols, utf8.RuneCountInString(line))
+	}
+	if err := validateGridSizeMax(len(lines), cols); err != nil {
+		return nil, err
+	}
+	g := &Grid{
+		B:    New(uint(len(lines) * cols)),
+		cols: cols,
+		rows: len(lines),
+	}
+	for r, line := range lines {
+		c := 0
+		for _, v := range line {
+			if v == setRune {
+				g.B.setBit(g.rowStart(r) + c)
+			}
+			c++
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,189 @@
+package btmp_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestGridString validates Grid.String() debug output.
+func TestGridString(t *testing.T) {
+	t.Run("matches Print", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 5)
+		g.B.SetBit(g.Index(0, 1)).SetBit(g.Index(1, 3))
+		want := "  0 1 2 3 4\n0 . # . . .\n1 . . . # .\n2 . . . . ."
+		if got := fmt.Sprintf("%v", g); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if got := g.String(); got != g.Print() {
+			t.Errorf("expected String() == Print(), got %q", got)
+		}
+	})
+
+	t.Run("empty grids", func(t *testing.T) {
+		tests := []struct {
+			g    *btmp.Grid
+			want string
+		}{
+			{btmp.NewGrid(), "Grid(0x0)"},
+			{btmp.NewGridWithSize(0, 4), "Grid(0x4)"},
+			{btmp.NewGridWithSize(3, 0), "Grid(3x0)"},
+		}
+		for _, tt := range tests {
+			if got := tt.g.String(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		}
+	})
+}
+
+// TestParseGrid validates ParseGrid() for both accepted layouts.
+func TestParseGrid(t *testing.T) {
+	t.Run("inverse of Print", func(t *testing.T) {
+		for _, g := range []*btmp.Grid{sampleGrid(3, 5), sampleGrid(12, 11), btmp.NewGrid()} {
+			got, err := btmp.ParseGrid(g.Print(), '#')
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(g) {
+				t.Errorf("expected:\n%v\ngot:\n%v", g, got)
+			}
+		}
+	})
+
+	t.Run("plain art pads short lines", func(t *testing.T) {
+		got, err := btmp.ParseGrid(`
+#..#
+.#
+..#.
+`, '#')
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := btmp.GridFromBools([][]bool{
+			{true, false, false, true},
+			{false, true, false, false},
+			{false, false, true, false},
+		})
+		if !got.Equal(want) {
+			t.Errorf("expected:\n%v\ngot:\n%v", want, got)
+		}
+	})
+
+	t.Run("custom set rune", func(t *testing.T) {
+		got, err := btmp.ParseGrid("x#\n#x", 'x')
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.B.Count() != 2 || !got.B.Test(got.Index(0, 0)) || !got.B.Test(got.Index(1, 1)) {
+			t.Errorf("expected diagonal cells set, got:\n%v", got)
+		}
+	})
+
+	t.Run("rejects malformed labeled input", func(t *testing.T) {
+		tests := []struct {
+			name string
+			s    string
+		}{
+			{"short row", "  0 1 2\n0 . # .\n1 . #"},
+			{"wrong row label", "  0 1\n0 . #\n2 # ."},
+			{"multi-rune cell", "  0 1\n0 .. #"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := btmp.ParseGrid(tt.s, '#'); err == nil {
+					t.Error("expected error, got nil")
+				}
+			})
+		}
+	})
+}
+
+// TestGridPrintWith validates Grid.PrintWith() options.
+func TestGridPrintWith(t *testing.T) {
+	g := btmp.NewGridWithSize(3, 5)
+	g.B.SetBit(g.Index(0, 1)).SetBit(g.Index(1, 3))
+
+	t.Run("defaults match Print", func(t *testing.T) {
+		for _, g := range []*btmp.Grid{g, sampleGrid(11, 12), btmp.NewGrid()} {
+			if got, want := g.PrintWith(btmp.DefaultGridPrintOptions()), g.Print(); got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		}
+	})
+
+	t.Run("compact glyphs without labels", func(t *testing.T) {
+		opts := btmp.GridPrintOptions{Set: '█', Clear: '░'}
+		want := "░█░░░\n░░░█░\n░░░░░"
+		if got := g.PrintWith(opts); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("labels with custom separator", func(t *testing.T) {
+		opts := btmp.DefaultGridPrintOptions()
+		opts.Sep = "|"
+		want := "  0|1|2|3|4\n0 .|#|.|.|.\n1 .|.|.|#|.\n2 .|.|.|.|."
+		if got := g.PrintWith(opts); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("unlabeled output parses back", func(t *testing.T) {
+		src := sampleGrid(4, 9)
+		opts := btmp.DefaultGridPrintOptions()
+		opts.Labels = false
+		opts.Sep = ""
+		got, err := btmp.ParseGrid(src.PrintWith(opts), '#')
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !got.Equal(src) {
+			t.Errorf("expected:\n%v\ngot:\n%v", src, got)
+		}
+	})
+}
+
+// TestGridToImage validates Grid.ToImage() rendering.
+func TestGridToImage(t *testing.T) {
+	set := color.RGBA{R: 255, A: 255}
+	clear := color.RGBA{A: 255}
+
+	t.Run("cells become blocks", func(t *testing.T) {
+		g := sampleGrid(3, 5)
+		img := g.ToImage(4, set, clear)
+		if got := img.Bounds(); got != image.Rect(0, 0, 20, 12) {
+			t.Fatalf("expected bounds %v, got %v", image.Rect(0, 0, 20, 12), got)
+		}
+		for y := range 12 {
+			for x := range 20 {
+				want := color.Color(clear)
+				if g.B.Test(g.Index(y/4, x/4)) {
+					want = set
+				}
+				if got := color.RGBAModel.Convert(img.At(x, y)); got != want {
+					t.Fatalf("pixel (%d,%d): expected %v, got %v", x, y, want, got)
+				}
+			}
+		}
+	})
+
+	t.Run("empty grid", func(t *testing.T) {
+		img := btmp.NewGridWithSize(0, 3).ToImage(2, set, clear)
+		if !img.Bounds().Empty() {
+			t.Errorf("expected zero-sized image, got %v", img.Bounds())
+		}
+	})
+
+	t.Run("panics on non-positive cell size", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for cellSize 0")
+			}
+		}()
+		sampleGrid(2, 2).ToImage(0, set, clear)
+	})
+}
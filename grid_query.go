@@ -1,5 +1,7 @@
 package btmp
 
+import "iter"
+
 // ========================================
 // Internal Helpers
 // ========================================
@@ -33,19 +35,43 @@ func (g *Grid) rectZero(r, c, h, w int) bool {
 // rectOne reports whether the specified rectangle contains only ones.
 // Internal implementation - no validation, assumes valid bounds.
 func (g *Grid) rectOne(r, c, h, w int) bool {
-	// Check each row of the rectangle
+	return g.allRect(r, c, h, w)
+}
+
+// allRect reports whether every cell in the specified rectangle is set.
+// Uses a range check per row and stops at the first non-full row.
+// Internal implementation - no validation, assumes valid bounds.
+func (g *Grid) allRect(r, c, h, w int) bool {
 	for row := range h {
-		start := (r+row)*g.cols + c
-		// Check if any bit is zero in this row segment
-		for i := range w {
-			if !g.B.Test(start + i) {
-				return false
-			}
+		if !g.B.allRange((r+row)*g.cols+c, w) {
+			return false
 		}
 	}
 	return true
 }
 
+// anyRect reports whether any cell in the specified rectangle is set.
+// Uses a range check per row and stops at the first occupied row.
+// Internal implementation - no validation, assumes valid bounds.
+func (g *Grid) anyRect(r, c, h, w int) bool {
+	for row := range h {
+		if g.B.anyRange((r+row)*g.cols+c, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// countRect returns the number of set cells in the specified rectangle.
+// Internal implementation - no validation, assumes valid bounds.
+func (g *Grid) countRect(r, c, h, w int) int {
+	n := 0
+	for row := range h {
+		n += g.B.countRange((r+row)*g.cols+c, w)
+	}
+	return n
+}
+
 // nextZeroInRow returns the column index of the next zero bit in row r,
 // starting search from column c.
 // Returns -1 if no zero bit exists in [c, Cols()).
@@ -190,6 +216,48 @@ func (g *Grid) countOnesFromInRowRange(r, c, count int) int {
 	return g.B.CountOnesFromInRange(start, searchCount)
 }
 
+// nextBitInCol returns the row index of the next cell in column c matching
+// target, starting search from row r. Cells are strided by Cols(), so the scan
+// tests one bit per row: O(Rows()-r).
+// Returns -1 if no matching cell exists in [r, Rows()).
+// Internal implementation - no validation.
+func (g *Grid) nextBitInCol(r, c int, target bool) int {
+	for row := r; row < g.rows; row++ {
+		if g.B.test(g.rowStart(row)+c) == target {
+			return row
+		}
+	}
+	return -1
+}
+
+// countBitsFromInCol returns the count of consecutive cells in column c
+// matching target, starting at row r and moving down. Strided scan without
+// allocation: O(Rows()-r).
+// Returns 0 if cell (r,c) does not match target.
+// Internal implementation - no validation.
+func (g *Grid) countBitsFromInCol(r, c int, target bool) int {
+	end := g.nextBitInCol(r, c, !target)
+	if end == -1 {
+		end = g.rows
+	}
+	return end - r
+}
+
+// canFitHeight reports whether cells [r, r+h) in column c are all zero.
+// Returns false if r+h > Rows().
+// Internal implementation - no validation.
+func (g *Grid) canFitHeight(r, c, h int) bool {
+	if r+h > g.rows {
+		return false
+	}
+	for row := r; row < r+h; row++ {
+		if g.B.test(g.rowStart(row) + c) {
+			return false
+		}
+	}
+	return true
+}
+
 // allRow returns true if all bits in row r are set.
 // Returns false for empty row.
 // Internal implementation - no validation.
@@ -202,3 +270,91 @@ func (g *Grid) allRow(r int) bool {
 	start := g.rowStart(r)
 	return g.B.AllRange(start, g.cols)
 }
+
+// findFreeRect returns the top-left-most origin of an all-zero h×w rectangle,
+// scanning rows top-to-bottom and columns left-to-right.
+// Occupied cells are skipped a run at a time instead of testing each origin.
+// Internal implementation - no validation, assumes h > 0 and w > 0.
+func (g *Grid) findFreeRect(h, w int) (r, c int, ok bool) {
+	if h > g.rows || w > g.cols {
+		return 0, 0, false
+	}
+
+	for r = 0; r+h <= g.rows; r++ {
+		c = 0
+		for c+w <= g.cols {
+			// Jump to the next free cell in the top row
+			c = g.nextZeroInRow(r, c)
+			if c == -1 || c+w > g.cols {
+				break
+			}
+
+			// Top row must be free across the full width
+			if run := g.countZerosFromInRowRange(r, c, w); run < w {
+				c += run + 1
+				continue
+			}
+
+			// Remaining rows: skip past the first occupied cell found
+			blocked := -1
+			for row := r + 1; row < r+h; row++ {
+				if one := g.nextOneInRowRange(row, c, w); one != -1 {
+					blocked = one
+					break
+				}
+			}
+			if blocked == -1 {
+				return r, c, true
+			}
+			c = blocked + 1
+		}
+	}
+	return 0, 0, false
+}
+
+// allCol returns true if all cells in column c are set.
+// Returns false for empty column (Rows() == 0).
+// Internal implementation - no validation.
+func (g *Grid) allCol(c int) bool {
+	if g.rows == 0 {
+		return false
+	}
+	return g.nextBitInCol(0, c, false) == -1
+}
+
+// anyCol returns true if any cell in column c is set.
+// Internal implementation - no validation.
+func (g *Grid) anyCol(c int) bool {
+	return g.nextBitInCol(0, c, true) != -1
+}
+
+// countRow returns the number of set cells in row r.
+// Internal implementation - no validation.
+func (g *Grid) countRow(r int) int {
+	return g.B.countRange(g.rowStart(r), g.cols)
+}
+
+// countCol returns the number of set cells in column c.
+// Strided popcount: one bit test per row, O(Rows()).
+// Internal implementation - no validation.
+func (g *Grid) countCol(c int) int {
+	n := 0
+	for row := range g.rows {
+		if g.B.test(g.rowStart(row) + c) {
+			n++
+		}
+	}
+	return n
+}
+
+// cells returns an iterator over (row, col) of each set cell in row-major order.
+// Internal implementation - no validation.
+func (g *Grid) cells() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := range g.B.bitsSeq(true) {
+			if !yield(i/g.cols, i%g.cols) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,495 @@
+package btmp_test
+
+import (
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestGridFindFreeRect validates Grid.FindFreeRect() first-fit search.
+func TestGridFindFreeRect(t *testing.T) {
+	// bruteForce returns the first origin in row-major order where RectZero holds.
+	bruteForce := func(g *btmp.Grid, h, w int) (int, int, bool) {
+		for r := 0; r+h <= g.Rows(); r++ {
+			for c := 0; c+w <= g.Cols(); c++ {
+				if g.RectZero(r, c, h, w) {
+					return r, c, true
+				}
+			}
+		}
+		return 0, 0, false
+	}
+
+	t.Run("matches exhaustive search", func(t *testing.T) {
+		g := btmp.NewGridWithSize(12, 80)
+		for i := range g.B.Len() {
+			if (i*7919)%11 < 3 {
+				g.B.SetBit(i)
+			}
+		}
+		for h := 1; h <= 4; h++ {
+			for w := 1; w <= 6; w++ {
+				r, c, ok := g.FindFreeRect(h, w)
+				wr, wc, wok := bruteForce(g, h, w)
+				if r != wr || c != wc || ok != wok {
+					t.Errorf("FindFreeRect(%d, %d): expected (%d,%d,%v), got (%d,%d,%v)", h, w, wr, wc, wok, r, c, ok)
+				}
+			}
+		}
+	})
+
+	t.Run("empty grid area fits at origin", func(t *testing.T) {
+		r, c, ok := btmp.NewGridWithSize(3, 3).FindFreeRect(3, 3)
+		if !ok || r != 0 || c != 0 {
+			t.Errorf("expected (0,0,true), got (%d,%d,%v)", r, c, ok)
+		}
+	})
+
+	t.Run("skips occupied region", func(t *testing.T) {
+		g := btmp.NewGridWithSize(4, 6).SetRect(0, 0, 2, 4)
+		r, c, ok := g.FindFreeRect(2, 2)
+		if !ok || r != 0 || c != 4 {
+			t.Errorf("expected (0,4,true), got (%d,%d,%v)", r, c, ok)
+		}
+	})
+
+	t.Run("no fit when larger than grid", func(t *testing.T) {
+		if _, _, ok := btmp.NewGridWithSize(3, 3).FindFreeRect(4, 1); ok {
+			t.Error("expected no fit")
+		}
+		if _, _, ok := btmp.NewGrid().FindFreeRect(1, 1); ok {
+			t.Error("expected no fit in empty grid")
+		}
+	})
+
+	t.Run("no fit when full", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 3).SetRect(0, 0, 3, 3)
+		if _, _, ok := g.FindFreeRect(1, 1); ok {
+			t.Error("expected no fit")
+		}
+	})
+
+	t.Run("panics on non-positive size", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for w=0")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).FindFreeRect(1, 0)
+	})
+}
+
+// TestGridNextFreeRow validates Grid.NextFreeRow() column scanning.
+func TestGridNextFreeRow(t *testing.T) {
+	g := btmp.NewGridWithSize(6, 5).SetRect(0, 2, 3, 1).SetRect(4, 2, 2, 1).SetRect(0, 0, 6, 1)
+
+	tests := []struct {
+		c, r int
+		want int
+	}{
+		{2, 0, 3},
+		{2, 3, 3},
+		{2, 4, -1},
+		{0, 0, -1},
+		{1, 0, 0},
+		{1, 5, 5},
+	}
+	for _, tt := range tests {
+		if got := g.NextFreeRow(tt.c, tt.r); got != tt.want {
+			t.Errorf("NextFreeRow(%d, %d): expected %d, got %d", tt.c, tt.r, tt.want, got)
+		}
+	}
+
+	t.Run("panics when row out of bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for r >= Rows()")
+			}
+		}()
+		g.NextFreeRow(0, 6)
+	})
+
+	t.Run("panics when column out of bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for c >= Cols()")
+			}
+		}()
+		g.NextFreeRow(5, 0)
+	})
+}
+
+// TestGridFreeRowsFrom validates Grid.FreeRowsFrom() vertical run counting.
+func TestGridFreeRowsFrom(t *testing.T) {
+	g := btmp.NewGridWithSize(6, 5).SetRect(3, 2, 1, 1)
+
+	tests := []struct {
+		r, c int
+		want int
+	}{
+		{0, 2, 3},
+		{2, 2, 1},
+		{3, 2, 0},
+		{4, 2, 2},
+		{0, 0, 6},
+		{5, 4, 1},
+	}
+	for _, tt := range tests {
+		if got := g.FreeRowsFrom(tt.r, tt.c); got != tt.want {
+			t.Errorf("FreeRowsFrom(%d, %d): expected %d, got %d", tt.r, tt.c, tt.want, got)
+		}
+	}
+
+	t.Run("panics on out-of-bounds coordinate", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds coordinate")
+			}
+		}()
+		g.FreeRowsFrom(6, 0)
+	})
+}
+
+// TestGridCanFitHeight validates Grid.CanFitHeight() vertical fit checks.
+func TestGridCanFitHeight(t *testing.T) {
+	g := btmp.NewGridWithSize(6, 5).SetRect(3, 2, 1, 1)
+
+	tests := []struct {
+		r, c, h int
+		want    bool
+	}{
+		{0, 2, 3, true},
+		{0, 2, 4, false},
+		{4, 2, 2, true},
+		{4, 2, 3, false},
+		{0, 0, 6, true},
+		{5, 4, 1, true},
+		{3, 2, 1, false},
+	}
+	for _, tt := range tests {
+		if got := g.CanFitHeight(tt.r, tt.c, tt.h); got != tt.want {
+			t.Errorf("CanFitHeight(%d, %d, %d): expected %v, got %v", tt.r, tt.c, tt.h, tt.want, got)
+		}
+	}
+
+	t.Run("panics on non-positive height", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for h=0")
+			}
+		}()
+		g.CanFitHeight(0, 0, 0)
+	})
+
+	t.Run("panics on out-of-bounds column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for c >= Cols()")
+			}
+		}()
+		g.CanFitHeight(0, 5, 1)
+	})
+}
+
+// TestGridNextInCol validates Grid.NextZeroInCol() and Grid.NextOneInCol().
+func TestGridNextInCol(t *testing.T) {
+	g := btmp.NewGridWithSize(7, 70).SetRect(1, 65, 2, 1).SetRect(5, 65, 1, 1).SetRect(0, 0, 7, 1)
+
+	tests := []struct {
+		r, c         int
+		wantZ, wantO int
+	}{
+		{0, 65, 0, 1},
+		{1, 65, 3, 1},
+		{3, 65, 3, 5},
+		{5, 65, 6, 5},
+		{6, 65, 6, -1},
+		{0, 0, -1, 0},
+		{0, 69, 0, -1},
+	}
+	for _, tt := range tests {
+		if got := g.NextZeroInCol(tt.r, tt.c); got != tt.wantZ {
+			t.Errorf("NextZeroInCol(%d, %d): expected %d, got %d", tt.r, tt.c, tt.wantZ, got)
+		}
+		if got := g.NextOneInCol(tt.r, tt.c); got != tt.wantO {
+			t.Errorf("NextOneInCol(%d, %d): expected %d, got %d", tt.r, tt.c, tt.wantO, got)
+		}
+	}
+
+	t.Run("panics on out-of-bounds coordinate", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds coordinate")
+			}
+		}()
+		g.NextOneInCol(7, 0)
+	})
+}
+
+// TestGridCountFromInCol validates Grid.CountZerosFromInCol() and Grid.CountOnesFromInCol().
+func TestGridCountFromInCol(t *testing.T) {
+	g := btmp.NewGridWithSize(7, 4).SetRect(1, 3, 3, 1).SetRect(6, 3, 1, 1)
+
+	tests := []struct {
+		r, c         int
+		wantZ, wantO int
+	}{
+		{0, 3, 1, 0},
+		{1, 3, 0, 3},
+		{2, 3, 0, 2},
+		{4, 3, 2, 0},
+		{6, 3, 0, 1},
+		{0, 0, 7, 0},
+	}
+	for _, tt := range tests {
+		if got := g.CountZerosFromInCol(tt.r, tt.c); got != tt.wantZ {
+			t.Errorf("CountZerosFromInCol(%d, %d): expected %d, got %d", tt.r, tt.c, tt.wantZ, got)
+		}
+		if got := g.CountOnesFromInCol(tt.r, tt.c); got != tt.wantO {
+			t.Errorf("CountOnesFromInCol(%d, %d): expected %d, got %d", tt.r, tt.c, tt.wantO, got)
+		}
+	}
+
+	t.Run("panics on out-of-bounds coordinate", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds coordinate")
+			}
+		}()
+		g.CountZerosFromInCol(0, 4)
+	})
+}
+
+// TestGridAllColAnyCol validates Grid.AllCol() and Grid.AnyCol().
+func TestGridAllColAnyCol(t *testing.T) {
+	g := btmp.NewGridWithSize(5, 4).SetRect(0, 1, 5, 1).SetRect(2, 2, 1, 1)
+
+	tests := []struct {
+		c        int
+		all, any bool
+	}{
+		{0, false, false},
+		{1, true, true},
+		{2, false, true},
+		{3, false, false},
+	}
+	for _, tt := range tests {
+		if got := g.AllCol(tt.c); got != tt.all {
+			t.Errorf("AllCol(%d): expected %v, got %v", tt.c, tt.all, got)
+		}
+		if got := g.AnyCol(tt.c); got != tt.any {
+			t.Errorf("AnyCol(%d): expected %v, got %v", tt.c, tt.any, got)
+		}
+	}
+
+	t.Run("zero rows", func(t *testing.T) {
+		g := btmp.NewGridWithSize(0, 3)
+		if g.AllCol(0) || g.AnyCol(0) {
+			t.Error("expected false for empty column")
+		}
+	})
+
+	t.Run("panics on out-of-bounds column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for c >= Cols()")
+			}
+		}()
+		g.AllCol(4)
+	})
+
+	t.Run("panics on negative column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative c")
+			}
+		}()
+		g.AnyCol(-1)
+	})
+}
+
+// TestGridCountRowCol validates Grid.CountRow() and Grid.CountCol().
+func TestGridCountRowCol(t *testing.T) {
+	g := btmp.NewGridWithSize(4, 70).SetRect(0, 60, 2, 10).SetRect(3, 0, 1, 70)
+
+	rows := []int{10, 10, 0, 70}
+	for r, want := range rows {
+		if got := g.CountRow(r); got != want {
+			t.Errorf("CountRow(%d): expected %d, got %d", r, want, got)
+		}
+	}
+
+	cols := map[int]int{0: 1, 59: 1, 60: 3, 69: 3}
+	for c, want := range cols {
+		if got := g.CountCol(c); got != want {
+			t.Errorf("CountCol(%d): expected %d, got %d", c, want, got)
+		}
+	}
+
+	t.Run("empty row and column", func(t *testing.T) {
+		if got := btmp.NewGridWithSize(0, 3).CountCol(1); got != 0 {
+			t.Errorf("expected 0, got %d", got)
+		}
+		if got := btmp.NewGridWithSize(3, 0).CountRow(1); got != 0 {
+			t.Errorf("expected 0, got %d", got)
+		}
+	})
+
+	t.Run("panics on out-of-bounds row", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for r >= Rows()")
+			}
+		}()
+		g.CountRow(4)
+	})
+
+	t.Run("panics on out-of-bounds column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for c >= Cols()")
+			}
+		}()
+		g.CountCol(70)
+	})
+}
+
+// TestGridCountRect validates Grid.CountRect() population counts.
+func TestGridCountRect(t *testing.T) {
+	g := btmp.NewGridWithSize(5, 80).SetRect(1, 60, 3, 10).SetRect(4, 0, 1, 1)
+
+	tests := []struct {
+		r, c, h, w int
+		want       int
+	}{
+		{0, 0, 5, 80, 31},
+		{1, 60, 3, 10, 30},
+		{0, 55, 5, 10, 15},
+		{0, 0, 4, 60, 0},
+		{4, 0, 1, 1, 1},
+	}
+	for _, tt := range tests {
+		if got := g.CountRect(tt.r, tt.c, tt.h, tt.w); got != tt.want {
+			t.Errorf("CountRect(%d, %d, %d, %d): expected %d, got %d", tt.r, tt.c, tt.h, tt.w, tt.want, got)
+		}
+	}
+
+	t.Run("panics when rectangle exceeds bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds rectangle")
+			}
+		}()
+		g.CountRect(4, 0, 2, 1)
+	})
+}
+
+// TestGridAnyRect validates Grid.AnyRect() as the inverse of RectZero.
+func TestGridAnyRect(t *testing.T) {
+	g := btmp.NewGridWithSize(5, 80).SetRect(3, 70, 1, 1)
+
+	tests := []struct {
+		r, c, h, w int
+		want       bool
+	}{
+		{0, 0, 5, 80, true},
+		{0, 0, 3, 80, false},
+		{3, 70, 1, 1, true},
+		{2, 60, 3, 10, false},
+		{2, 60, 3, 11, true},
+	}
+	for _, tt := range tests {
+		got := g.AnyRect(tt.r, tt.c, tt.h, tt.w)
+		if got != tt.want {
+			t.Errorf("AnyRect(%d, %d, %d, %d): expected %v, got %v", tt.r, tt.c, tt.h, tt.w, tt.want, got)
+		}
+		if got == g.RectZero(tt.r, tt.c, tt.h, tt.w) {
+			t.Errorf("AnyRect(%d, %d, %d, %d): expected inverse of RectZero", tt.r, tt.c, tt.h, tt.w)
+		}
+	}
+
+	t.Run("panics when rectangle exceeds bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds rectangle")
+			}
+		}()
+		g.AnyRect(0, 79, 1, 2)
+	})
+}
+
+// TestGridAllRect validates Grid.AllRect() full-occupancy checks.
+func TestGridAllRect(t *testing.T) {
+	g := btmp.NewGridWithSize(5, 80).SetRect(1, 60, 3, 20).ClearRect(3, 79, 1, 1)
+
+	tests := []struct {
+		r, c, h, w int
+		want       bool
+	}{
+		{1, 60, 2, 20, true},
+		{1, 60, 3, 20, false},
+		{1, 60, 3, 19, true},
+		{0, 60, 2, 1, false},
+		{2, 70, 1, 1, true},
+	}
+	for _, tt := range tests {
+		got := g.AllRect(tt.r, tt.c, tt.h, tt.w)
+		if got != tt.want {
+			t.Errorf("AllRect(%d, %d, %d, %d): expected %v, got %v", tt.r, tt.c, tt.h, tt.w, tt.want, got)
+		}
+		if got != g.RectOne(tt.r, tt.c, tt.h, tt.w) {
+			t.Errorf("AllRect(%d, %d, %d, %d): expected same result as RectOne", tt.r, tt.c, tt.h, tt.w)
+		}
+	}
+
+	t.Run("panics when rectangle exceeds bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds rectangle")
+			}
+		}()
+		g.AllRect(4, 0, 2, 1)
+	})
+}
+
+// TestGridCells validates Grid.Cells() iteration.
+func TestGridCells(t *testing.T) {
+	t.Run("yields set cells in row-major order", func(t *testing.T) {
+		g := sampleGrid(4, 70)
+		var got [][2]int
+		for r, c := range g.Cells() {
+			got = append(got, [2]int{r, c})
+		}
+		if len(got) != g.B.Count() {
+			t.Fatalf("expected %d cells, got %d", g.B.Count(), len(got))
+		}
+		for i, rc := range got {
+			if !g.B.Test(g.Index(rc[0], rc[1])) {
+				t.Errorf("cell (%d,%d) is not set", rc[0], rc[1])
+			}
+			if i > 0 && g.Index(rc[0], rc[1]) <= g.Index(got[i-1][0], got[i-1][1]) {
+				t.Errorf("expected row-major order at %d", i)
+			}
+		}
+	})
+
+	t.Run("honors break", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 3).SetRect(0, 0, 3, 3)
+		n := 0
+		for range g.Cells() {
+			n++
+			if n == 2 {
+				break
+			}
+		}
+		if n != 2 {
+			t.Errorf("expected 2 iterations, got %d", n)
+		}
+	})
+
+	t.Run("empty grid yields nothing", func(t *testing.T) {
+		for r, c := range btmp.NewGridWithSize(0, 5).Cells() {
+			t.Errorf("unexpected cell (%d,%d)", r, c)
+		}
+	})
+}
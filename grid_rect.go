@@ -30,59 +30,211 @@ func (g *Grid) clearRect(r, c, h, w int) {
 	}
 }
 
+// toggleRect flips every cell of the rectangle without validation.
+// Internal implementation - no auto-growth.
+func (g *Grid) toggleRect(r, c, h, w int) {
+	if h == 0 || w == 0 {
+		// Empty rectangle, nothing to do
+		return
+	}
+
+	// Flip each row of the rectangle
+	for row := range h {
+		start := (r+row)*g.cols + c
+		g.B.flipRange(start, w)
+	}
+}
+
+// copyRect copies the h×w block of src at (srcR,srcC) into g at (dstR,dstC).
+// When src == g and the rectangles overlap, rows are processed in an order
+// that never overwrites unread source rows, and each row copy is overlap-safe.
+// Internal implementation - no validation, requires both rectangles in-bounds.
+func (g *Grid) copyRect(src *Grid, srcR, srcC, h, w, dstR, dstC int) {
+	if h == 0 || w == 0 {
+		return
+	}
+
+	// Copy bottom-to-top when moving down within the same grid
+	if src == g && dstR > srcR {
+		for row := h - 1; row >= 0; row-- {
+			srcStart := (srcR+row)*src.cols + srcC
+			dstStart := (dstR+row)*g.cols + dstC
+			g.B.copyRange(src.B, srcStart, dstStart, w)
+		}
+		return
+	}
+
+	for row := range h {
+		srcStart := (srcR+row)*src.cols + srcC
+		dstStart := (dstR+row)*g.cols + dstC
+		g.B.copyRange(src.B, srcStart, dstStart, w)
+	}
+}
+
+// moveRect moves the h×w block at (r,c) to (dstR,dstC), clearing the part of
+// the source block not covered by the destination.
+// Internal implementation - no validation, requires in-bounds and destination free.
+func (g *Grid) moveRect(r, c, h, w, dstR, dstC int) {
+	if h == 0 || w == 0 || (r == dstR && c == dstC) {
+		return
+	}
+
+	g.copyRect(g, r, c, h, w, dstR, dstC)
+	g.rectSegmentsExcept(r, c, h, w, dstR, dstC, func(start, count int) bool {
+		g.B.clearRange(start, count)
+		return true
+	})
+}
+
+// rectZeroExcept reports whether the h×w block at (r,c) contains only zeros,
+// ignoring cells that also lie in the h×w block at (xr,xc).
+// Internal implementation - no validation, assumes valid bounds.
+func (g *Grid) rectZeroExcept(r, c, h, w, xr, xc int) bool {
+	zero := true
+	g.rectSegmentsExcept(r, c, h, w, xr, xc, func(start, count int) bool {
+		zero = !g.B.anyRange(start, count)
+		return zero
+	})
+	return zero
+}
+
+// rectSegmentsExcept calls fn with the bitmap (start, count) of each row
+// segment of the h×w block at (r,c) that lies outside the equally sized block
+// at (xr,xc). Stops early when fn returns false.
+// Internal helper - no validation.
+func (g *Grid) rectSegmentsExcept(r, c, h, w, xr, xc int, fn func(start, count int) bool) {
+	for row := r; row < r+h; row++ {
+		start := g.rowStart(row)
+
+		// Row outside the excluded block, or no column overlap: whole segment
+		if row < xr || row >= xr+h || c+w <= xc || xc+w <= c {
+			if !fn(start+c, w) {
+				return
+			}
+			continue
+		}
+
+		// Left part [c, xc) and right part [xc+w, c+w)
+		if xc > c && !fn(start+c, xc-c) {
+			return
+		}
+		if xc < c && !fn(start+xc+w, c-xc) {
+			return
+		}
+	}
+}
+
+// overlayAt ORs all cells of src onto g with src's origin at (dstR,dstC),
+// combining each row in chunks of up to 64 bits.
+// Internal implementation - no validation, requires src to fit.
+func (g *Grid) overlayAt(dstR, dstC int, src *Grid) {
+	for row := range src.rows {
+		from := src.rowStart(row)
+		to := g.rowStart(dstR+row) + dstC
+		for pos := 0; pos < src.cols; pos += WordBits {
+			k := min(WordBits, src.cols-pos)
+			if v := src.B.getBits(from+pos, k); v != 0 {
+				g.B.setBits(to+pos, k, g.B.getBits(to+pos, k)|v)
+			}
+		}
+	}
+}
+
+// collidesAt reports whether any set cell of src, placed with its origin at
+// (dstR,dstC), lands on a set cell of g.
+// Internal implementation - no validation, requires src to fit.
+func (g *Grid) collidesAt(dstR, dstC int, src *Grid) bool {
+	for row := range src.rows {
+		from := src.rowStart(row)
+		to := g.rowStart(dstR+row) + dstC
+		for pos := 0; pos < src.cols; pos += WordBits {
+			k := min(WordBits, src.cols-pos)
+			if src.B.getBits(from+pos, k)&g.B.getBits(to+pos, k) != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // shiftRectRight shifts a rectangle one column to the right.
-// Moves bits from [r,c,h,w) to [r,c+1,h,w).
-// The leftmost column (c) is cleared.
 // Internal implementation - no validation, requires in-bounds and target column free.
 func (g *Grid) shiftRectRight(r, c, h, w int) {
+	g.shiftRectRightBy(r, c, h, w, 1)
+}
+
+// shiftRectRightBy shifts a rectangle n columns to the right.
+// Moves bits from [r,c,h,w) to [r,c+n,h,w) with one MoveRange per row.
+// Vacated source columns are cleared.
+// Internal implementation - no validation, requires in-bounds and target columns free.
+func (g *Grid) shiftRectRightBy(r, c, h, w, n int) {
 	if h == 0 || w == 0 {
 		return
 	}
 
 	for row := range h {
 		srcStart := (r+row)*g.cols + c
-		dstStart := srcStart + 1
+		dstStart := srcStart + n
 		g.B.MoveRange(srcStart, dstStart, w)
 	}
 }
 
 // shiftRectLeft shifts a rectangle one column to the left.
-// Moves bits from [r,c,h,w) to [r,c-1,h,w).
-// The rightmost column (c+w-1) is cleared.
 // Internal implementation - no validation, requires in-bounds and target column free.
 func (g *Grid) shiftRectLeft(r, c, h, w int) {
+	g.shiftRectLeftBy(r, c, h, w, 1)
+}
+
+// shiftRectLeftBy shifts a rectangle n columns to the left.
+// Moves bits from [r,c,h,w) to [r,c-n,h,w) with one MoveRange per row.
+// Vacated source columns are cleared.
+// Internal implementation - no validation, requires in-bounds and target columns free.
+func (g *Grid) shiftRectLeftBy(r, c, h, w, n int) {
 	if h == 0 || w == 0 {
 		return
 	}
 
 	for row := range h {
 		srcStart := (r+row)*g.cols + c
-		dstStart := srcStart - 1
+		dstStart := srcStart - n
 		g.B.MoveRange(srcStart, dstStart, w)
 	}
 }
 
 // shiftRectUp shifts a rectangle one row up.
-// Moves bits from [r,c,h,w) to [r-1,c,h,w).
-// The bottom row (r+h-1) is cleared.
 // Internal implementation - no validation, requires in-bounds and target row free.
 func (g *Grid) shiftRectUp(r, c, h, w int) {
+	g.shiftRectUpBy(r, c, h, w, 1)
+}
+
+// shiftRectUpBy shifts a rectangle n rows up.
+// Moves bits from [r,c,h,w) to [r-n,c,h,w) with one MoveRange per row.
+// Vacated source rows are cleared.
+// Internal implementation - no validation, requires in-bounds and target rows free.
+func (g *Grid) shiftRectUpBy(r, c, h, w, n int) {
 	if h == 0 || w == 0 {
 		return
 	}
 
+	// Process rows top to bottom so no unread source row is overwritten
 	for row := range h {
 		srcStart := (r+row)*g.cols + c
-		dstStart := ((r-1)+row)*g.cols + c
+		dstStart := ((r-n)+row)*g.cols + c
 		g.B.MoveRange(srcStart, dstStart, w)
 	}
 }
 
 // shiftRectDown shifts a rectangle one row down.
-// Moves bits from [r,c,h,w) to [r+1,c,h,w).
-// The top row (r) is cleared.
 // Internal implementation - no validation, requires in-bounds and target row free.
 func (g *Grid) shiftRectDown(r, c, h, w int) {
+	g.shiftRectDownBy(r, c, h, w, 1)
+}
+
+// shiftRectDownBy shifts a rectangle n rows down.
+// Moves bits from [r,c,h,w) to [r+n,c,h,w) with one MoveRange per row.
+// Vacated source rows are cleared.
+// Internal implementation - no validation, requires in-bounds and target rows free.
+func (g *Grid) shiftRectDownBy(r, c, h, w, n int) {
 	if h == 0 || w == 0 {
 		return
 	}
@@ -90,7 +242,7 @@ func (g *Grid) shiftRectDown(r, c, h, w int) {
 	// Process rows in reverse to avoid overlap issues
 	for row := h - 1; row >= 0; row-- {
 		srcStart := (r+row)*g.cols + c
-		dstStart := ((r+1)+row)*g.cols + c
+		dstStart := ((r+n)+row)*g.cols + c
 		g.B.MoveRange(srcStart, dstStart, w)
 	}
 }
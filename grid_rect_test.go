@@ -993,3 +993,389 @@ func TestGridShiftRectDown(t *testing.T) {
 		}
 	})
 }
+
+// TestGridCopyRect validates Grid.CopyRect() between and within grids.
+func TestGridCopyRect(t *testing.T) {
+	t.Run("copies block between grids", func(t *testing.T) {
+		src := btmp.NewGridWithSize(4, 5)
+		src.B.SetBit(src.Index(1, 1)).SetBit(src.Index(2, 3))
+		dst := btmp.NewGridWithSize(6, 70)
+		dst.SetRect(0, 0, 6, 1)
+
+		dst.CopyRect(src, 1, 1, 2, 3, 3, 60)
+
+		if !dst.B.Test(dst.Index(3, 60)) || !dst.B.Test(dst.Index(4, 62)) {
+			t.Error("expected copied cells at (3,60) and (4,62)")
+		}
+		if dst.B.Count() != 8 {
+			t.Errorf("expected count=8, got %d", dst.B.Count())
+		}
+		if src.B.Count() != 2 {
+			t.Errorf("expected source unchanged, got count=%d", src.B.Count())
+		}
+	})
+
+	t.Run("overwrites destination block", func(t *testing.T) {
+		src := btmp.NewGridWithSize(2, 2)
+		dst := btmp.NewGridWithSize(4, 4).SetRect(0, 0, 4, 4)
+		dst.CopyRect(src, 0, 0, 2, 2, 1, 1)
+		if dst.B.Count() != 12 || !dst.RectZero(1, 1, 2, 2) {
+			t.Errorf("expected cleared 2x2 block, got count=%d", dst.B.Count())
+		}
+	})
+
+	t.Run("overlapping copy within same grid", func(t *testing.T) {
+		for _, d := range []struct{ dr, dc int }{{1, 1}, {-1, -1}, {0, 2}, {2, 0}, {-2, 1}} {
+			g := btmp.NewGridWithSize(8, 8)
+			for r := 2; r < 6; r++ {
+				for c := 2; c < 6; c++ {
+					if (r+c)%2 == 0 || r == 2 {
+						g.B.SetBit(g.Index(r, c))
+					}
+				}
+			}
+			want := g.Clone()
+			g.CopyRect(g, 2, 2, 4, 4, 2+d.dr, 2+d.dc)
+			for r := range 4 {
+				for c := range 4 {
+					if g.B.Test(g.Index(2+d.dr+r, 2+d.dc+c)) != want.B.Test(want.Index(2+r, 2+c)) {
+						t.Fatalf("delta %v: cell (%d,%d) mismatch", d, r, c)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("panics when destination out of bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds destination")
+			}
+		}()
+		btmp.NewGridWithSize(4, 4).CopyRect(btmp.NewGridWithSize(4, 4), 0, 0, 2, 2, 3, 3)
+	})
+
+	t.Run("panics when source out of bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds source")
+			}
+		}()
+		btmp.NewGridWithSize(4, 4).CopyRect(btmp.NewGridWithSize(2, 2), 1, 1, 2, 2, 0, 0)
+	})
+}
+
+// TestGridMoveRect validates Grid.MoveRect() relocation.
+func TestGridMoveRect(t *testing.T) {
+	// pattern builds a 10x10 grid with an irregular 3x4 block at (2,2).
+	pattern := func() *btmp.Grid {
+		g := btmp.NewGridWithSize(10, 10)
+		g.SetRect(2, 2, 1, 4).SetRect(3, 3, 2, 1).SetRect(4, 5, 1, 1)
+		return g
+	}
+
+	for _, d := range []struct{ dstR, dstC int }{{6, 6}, {0, 0}, {3, 3}, {1, 2}, {2, 4}, {4, 1}, {2, 2}} {
+		g := pattern()
+		want := g.Clone()
+		g.MoveRect(2, 2, 3, 4, d.dstR, d.dstC)
+
+		if g.B.Count() != want.B.Count() {
+			t.Errorf("dst (%d,%d): expected count=%d, got %d", d.dstR, d.dstC, want.B.Count(), g.B.Count())
+		}
+		for r := range 3 {
+			for c := range 4 {
+				if g.B.Test(g.Index(d.dstR+r, d.dstC+c)) != want.B.Test(want.Index(2+r, 2+c)) {
+					t.Fatalf("dst (%d,%d): cell (%d,%d) mismatch", d.dstR, d.dstC, r, c)
+				}
+			}
+		}
+	}
+
+	t.Run("panics when destination occupied", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for occupied destination")
+			}
+		}()
+		g := pattern().SetRect(8, 8, 1, 1)
+		g.MoveRect(2, 2, 3, 4, 6, 6)
+	})
+
+	t.Run("panics when destination out of bounds", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds destination")
+			}
+		}()
+		pattern().MoveRect(2, 2, 3, 4, 8, 0)
+	})
+}
+
+// TestGridPlaceRect validates Grid.PlaceRect() find-and-occupy behavior.
+func TestGridPlaceRect(t *testing.T) {
+	t.Run("places consecutive rectangles", func(t *testing.T) {
+		g := btmp.NewGridWithSize(4, 6)
+		want := [][2]int{{0, 0}, {0, 3}, {2, 0}, {2, 3}}
+		for i, w := range want {
+			r, c, ok := g.PlaceRect(2, 3)
+			if !ok || r != w[0] || c != w[1] {
+				t.Fatalf("placement %d: expected (%d,%d,true), got (%d,%d,%v)", i, w[0], w[1], r, c, ok)
+			}
+			if g.B.Count() != (i+1)*6 {
+				t.Errorf("placement %d: expected count=%d, got %d", i, (i+1)*6, g.B.Count())
+			}
+		}
+	})
+
+	t.Run("leaves grid unchanged on failure", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 3).SetRect(1, 1, 1, 1)
+		before := g.Clone()
+		if _, _, ok := g.PlaceRect(2, 2); ok {
+			t.Fatal("expected no fit")
+		}
+		if !g.Equal(before) {
+			t.Error("expected grid unchanged")
+		}
+	})
+
+	t.Run("panics on non-positive size", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for h=0")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).PlaceRect(0, 1)
+	})
+}
+
+// TestGridOverlayAt validates Grid.OverlayAt() and Grid.CollidesAt().
+func TestGridOverlayAt(t *testing.T) {
+	sprite := btmp.NewGridWithSize(2, 70).SetRect(0, 0, 1, 70).SetRect(1, 65, 1, 1)
+
+	t.Run("ORs sprite at offset", func(t *testing.T) {
+		g := btmp.NewGridWithSize(5, 140).SetRect(4, 0, 1, 1)
+		g.OverlayAt(2, 3, sprite)
+		if g.B.Count() != 72 {
+			t.Errorf("expected count=72, got %d", g.B.Count())
+		}
+		if !g.AllRect(2, 3, 1, 70) || !g.B.Test(g.Index(3, 68)) || !g.B.Test(g.Index(4, 0)) {
+			t.Error("expected sprite cells and untouched background")
+		}
+		if sprite.B.Count() != 71 {
+			t.Error("expected src unchanged")
+		}
+	})
+
+	t.Run("CollidesAt detects overlap", func(t *testing.T) {
+		g := btmp.NewGridWithSize(5, 140).SetRect(3, 68, 1, 1)
+		if !g.CollidesAt(2, 3, sprite) {
+			t.Error("expected collision at (2,3)")
+		}
+		if g.CollidesAt(2, 4, sprite) {
+			t.Error("expected no collision at (2,4)")
+		}
+		if g.B.Count() != 1 {
+			t.Error("expected grid unchanged")
+		}
+	})
+
+	t.Run("empty src fits at edge", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 3)
+		g.OverlayAt(3, 3, btmp.NewGrid())
+		if g.CollidesAt(3, 3, btmp.NewGrid()) {
+			t.Error("expected no collision for empty src")
+		}
+	})
+
+	t.Run("panics when src overhangs", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for overhanging src")
+			}
+		}()
+		btmp.NewGridWithSize(5, 140).OverlayAt(4, 0, sprite)
+	})
+
+	t.Run("panics on negative offset", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative dstC")
+			}
+		}()
+		btmp.NewGridWithSize(5, 140).CollidesAt(0, -1, sprite)
+	})
+}
+
+// TestGridShiftRectHorizontalBy validates Grid.ShiftRectRightBy() and Grid.ShiftRectLeftBy().
+func TestGridShiftRectHorizontalBy(t *testing.T) {
+	// block builds a 4x80 grid with an irregular 2x3 block at (1,30).
+	block := func() *btmp.Grid {
+		return btmp.NewGridWithSize(4, 80).SetRect(1, 30, 1, 3).SetRect(2, 31, 1, 1)
+	}
+
+	for _, n := range []int{1, 2, 3, 5, 40} {
+		src := block()
+		right := block().ShiftRectRightBy(1, 30, 2, 3, n)
+		left := block().ShiftRectLeftBy(1, 30, 2, 3, min(n, 30))
+
+		if !right.Equal(block().MoveRect(1, 30, 2, 3, 1, 30+n)) {
+			t.Errorf("RightBy(%d): expected block at column %d", n, 30+n)
+		}
+		if !left.Equal(block().MoveRect(1, 30, 2, 3, 1, 30-min(n, 30))) {
+			t.Errorf("LeftBy(%d): expected block at column %d", n, 30-min(n, 30))
+		}
+		if right.B.Count() != src.B.Count() || left.B.Count() != src.B.Count() {
+			t.Errorf("n=%d: expected count preserved", n)
+		}
+	}
+
+	t.Run("panics when intervening column occupied", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for occupied intervening column")
+			}
+		}()
+		block().SetRect(2, 34, 1, 1).ShiftRectRightBy(1, 30, 2, 3, 3)
+	})
+
+	t.Run("panics when target beyond right edge", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds target")
+			}
+		}()
+		block().ShiftRectRightBy(1, 30, 2, 3, 48)
+	})
+
+	t.Run("panics when target beyond left edge", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds target")
+			}
+		}()
+		block().ShiftRectLeftBy(1, 30, 2, 3, 31)
+	})
+
+	t.Run("panics on non-positive n", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for n=0")
+			}
+		}()
+		block().ShiftRectLeftBy(1, 30, 2, 3, 0)
+	})
+}
+
+// TestGridShiftRectVerticalBy validates Grid.ShiftRectUpBy() and Grid.ShiftRectDownBy().
+func TestGridShiftRectVerticalBy(t *testing.T) {
+	// block builds a 12x70 grid with an irregular 3x2 block at (5,66).
+	block := func() *btmp.Grid {
+		return btmp.NewGridWithSize(12, 70).SetRect(5, 66, 3, 1).SetRect(6, 67, 1, 1)
+	}
+
+	for _, n := range []int{1, 2, 3, 4} {
+		up := block().ShiftRectUpBy(5, 66, 3, 2, n)
+		down := block().ShiftRectDownBy(5, 66, 3, 2, n)
+
+		if !up.Equal(block().MoveRect(5, 66, 3, 2, 5-n, 66)) {
+			t.Errorf("UpBy(%d): expected block at row %d", n, 5-n)
+		}
+		if !down.Equal(block().MoveRect(5, 66, 3, 2, 5+n, 66)) {
+			t.Errorf("DownBy(%d): expected block at row %d", n, 5+n)
+		}
+		if up.B.Count() != 4 || down.B.Count() != 4 {
+			t.Errorf("n=%d: expected count preserved", n)
+		}
+	}
+
+	t.Run("panics when target band occupied", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for occupied target band")
+			}
+		}()
+		block().SetRect(2, 67, 1, 1).ShiftRectUpBy(5, 66, 3, 2, 3)
+	})
+
+	t.Run("panics when target beyond bottom edge", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds target")
+			}
+		}()
+		block().ShiftRectDownBy(5, 66, 3, 2, 5)
+	})
+
+	t.Run("panics when target beyond top edge", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds target")
+			}
+		}()
+		block().ShiftRectUpBy(5, 66, 3, 2, 6)
+	})
+}
+
+// TestGridToggleRect validates Grid.ToggleRect().
+func TestGridToggleRect(t *testing.T) {
+	t.Run("flips only the rectangle", func(t *testing.T) {
+		g := sampleGrid(5, 70)
+		orig := g.Clone()
+		g.ToggleRect(1, 60, 3, 8)
+		for r := range g.Rows() {
+			for c := range g.Cols() {
+				inside := r >= 1 && r < 4 && c >= 60 && c < 68
+				if want := orig.At(r, c) != inside; g.At(r, c) != want {
+					t.Errorf("(%d,%d): expected %v", r, c, want)
+				}
+			}
+		}
+	})
+
+	t.Run("twice restores", func(t *testing.T) {
+		g := sampleGrid(4, 9)
+		orig := g.Clone()
+		g.ToggleRect(0, 2, 4, 5).ToggleRect(0, 2, 4, 5)
+		if !g.Equal(orig) {
+			t.Errorf("expected:\n%v\ngot:\n%v", orig, g)
+		}
+	})
+
+	t.Run("panics like SetRect", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for rectangle exceeding columns")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).ToggleRect(0, 2, 1, 2)
+	})
+}
+
+// TestGridShiftRectErrorValue validates the Value reported by shift panics:
+// the bare direction for single steps, "<dir> by n" for multi-step shifts.
+func TestGridShiftRectErrorValue(t *testing.T) {
+	g := btmp.NewGridWithSize(5, 5).SetRect(2, 2, 1, 1)
+	tests := []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"ShiftRectRight", func() { g.ShiftRectRight(2, 1, 1, 1) }, "Grid.ShiftRectRight: shift: target column not free (got right)"},
+		{"ShiftRectLeft", func() { g.ShiftRectLeft(2, 3, 1, 1) }, "Grid.ShiftRectLeft: shift: target column not free (got left)"},
+		{"ShiftRectUp", func() { g.ShiftRectUp(3, 2, 1, 1) }, "Grid.ShiftRectUp: shift: target row not free (got up)"},
+		{"ShiftRectDown", func() { g.ShiftRectDown(1, 2, 1, 1) }, "Grid.ShiftRectDown: shift: target row not free (got down)"},
+		{"ShiftRectRight at edge", func() { g.ShiftRectRight(0, 4, 1, 1) }, "Grid.ShiftRectRight: shift: target column out of bounds (got right)"},
+		{"ShiftRectRightBy", func() { g.ShiftRectRightBy(2, 0, 1, 1, 2) }, "Grid.ShiftRectRightBy: shift: target column not free (got right by 2)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || err.Error() != tt.want {
+					t.Errorf("expected %q, got %v", tt.want, err)
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
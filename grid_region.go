@@ -0,0 +1,296 @@
+package btmp
+
+import "slices"
+
+// Neighbor offsets as (dr, dc) pairs for 4- and 8-connectivity.
+var (
+	neighbors4 = [][2]int{{-1, 0}, {0, -1}, {0, 1}, {1, 0}}
+	neighbors8 = [][2]int{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}
+)
+
+// neighborOffsets returns the 8-connected offsets if diagonal is set,
+// otherwise the 4-connected offsets.
+func neighborOffsets(diagonal bool) [][2]int {
+	if diagonal {
+		return neighbors8
+	}
+	return neighbors4
+}
+
+// maxEmptyRectangle returns the origin and size of a maximum-area all-zero
+// rectangle, or zeros if none exists.
+//
+// Algorithm: for each row, heights[c] holds the number of consecutive zero
+// cells ending at that row in column c. The largest rectangle whose bottom
+// edge lies on the row is then the largest rectangle in the histogram,
+// found with a monotonic stack in O(Cols()). Total O(Rows()*Cols()).
+//
+// Ties keep the first maximum found, scanning bottom edges top-to-bottom and
+// columns left-to-right.
+// Internal implementation - no validation.
+func (g *Grid) maxEmptyRectangle() (r, c, h, w int) {
+	if g.rows == 0 || g.cols == 0 {
+		return 0, 0, 0, 0
+	}
+
+	heights := make([]int, g.cols)
+	stack := make([]int, 0, g.cols+1)
+	best := 0
+
+	for row := range g.rows {
+		start := g.rowStart(row)
+		for col := range g.cols {
+			if g.B.test(start + col) {
+				heights[col] = 0
+			} else {
+				heights[col]++
+			}
+		}
+
+		stack = stack[:0]
+		for col := 0; col <= g.cols; col++ {
+			cur := 0
+			if col < g.cols {
+				cur = heights[col]
+			}
+			for len(stack) > 0 && heights[stack[len(stack)-1]] >= cur {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+
+				left := 0
+				if len(stack) > 0 {
+					left = stack[len(stack)-1] + 1
+				}
+				height, width := heights[top], col-left
+				if height*width > best {
+					best = height * width
+					r, c, h, w = row-height+1, left, height, width
+				}
+			}
+			stack = append(stack, col)
+		}
+	}
+	return r, c, h, w
+}
+
+// largestEmptySquare returns the origin and side length of the largest
+// all-zero square, or size 0 if none exists.
+//
+// Algorithm: dp[c] is the side of the largest zero square whose bottom-right
+// cell is (row, c); for a zero cell it is 1 + min(left, up, up-left).
+// A single row of dp plus the saved up-left value gives O(Cols()) space and
+// O(Rows()*Cols()) time. Scanning bottom-right corners in row-major order and
+// keeping only strict improvements breaks ties toward the top-left.
+// Internal implementation - no validation.
+func (g *Grid) largestEmptySquare() (r, c, size int) {
+	dp := make([]int, g.cols)
+
+	for row := range g.rows {
+		start := g.rowStart(row)
+		diag := 0 // dp value of (row-1, col-1)
+		for col := range g.cols {
+			up := dp[col]
+			if g.B.test(start + col) {
+				dp[col] = 0
+			} else {
+				left := 0
+				if col > 0 {
+					left = dp[col-1]
+				}
+				dp[col] = 1 + min(left, up, diag)
+				if dp[col] > size {
+					size = dp[col]
+					r, c = row-size+1, col-size+1
+				}
+			}
+			diag = up
+		}
+	}
+	return r, c, size
+}
+
+// connectedComponents groups set cells into connected components using
+// breadth-first search with an explicit queue and a visited bitmap.
+// Each component lists its cell indexes (r*Cols()+c) in ascending order;
+// components are ordered by their first cell.
+// Internal implementation - no validation.
+func (g *Grid) connectedComponents(diagonal bool) [][]int {
+	offsets := neighborOffsets(diagonal)
+	visited := New(uint(g.B.lenBits))
+	var comps [][]int
+
+	for seed := range g.B.bitsSeq(true) {
+		if visited.test(seed) {
+			continue
+		}
+		visited.setBit(seed)
+		comp := []int{seed}
+		for i := 0; i < len(comp); i++ {
+			r, c := comp[i]/g.cols, comp[i]%g.cols
+			for _, d := range offsets {
+				nr, nc := r+d[0], c+d[1]
+				if nr < 0 || nr >= g.rows || nc < 0 || nc >= g.cols {
+					continue
+				}
+				idx := g.rowStart(nr) + nc
+				if g.B.test(idx) && !visited.test(idx) {
+					visited.setBit(idx)
+					comp = append(comp, idx)
+				}
+			}
+		}
+		slices.Sort(comp)
+		comps = append(comps, comp)
+	}
+	return comps
+}
+
+// floodFill sets every cell 4-connected to (r,c) that matches the seed's
+// current value to value, using an explicit stack instead of recursion.
+// Internal implementation - no validation.
+func (g *Grid) floodFill(r, c int, value bool) {
+	seed := g.rowStart(r) + c
+	if g.B.test(seed) == value {
+		return
+	}
+
+	// Cells are flipped when pushed, so a cell is never pushed twice
+	g.B.flipBit(seed)
+	stack := []int{seed}
+	for len(stack) > 0 {
+		idx := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		cr, cc := idx/g.cols, idx%g.cols
+		for _, d := range neighbors4 {
+			nr, nc := cr+d[0], cc+d[1]
+			if nr < 0 || nr >= g.rows || nc < 0 || nc >= g.cols {
+				continue
+			}
+			n := g.rowStart(nr) + nc
+			if g.B.test(n) != value {
+				g.B.flipBit(n)
+				stack = append(stack, n)
+			}
+		}
+	}
+}
+
+// boundingBox returns the tight rectangle containing all set cells.
+// Row bounds come from the first and last set bits; column bounds from a
+// forward and backward range search in each row between them.
+// Internal implementation - no validation.
+func (g *Grid) boundingBox() (r, c, h, w int, ok bool) {
+	first := g.B.firstSet()
+	if first == -1 {
+		return 0, 0, 0, 0, false
+	}
+	last := g.B.lastSet()
+	top, bottom := first/g.cols, last/g.cols
+
+	left, right := g.cols, -1
+	for row := top; row <= bottom; row++ {
+		start := g.rowStart(row)
+		if p := g.B.nextOneInRange(start, left); p != -1 {
+			left = p - start
+		}
+		if p := g.B.prevOne(start + g.cols - 1); p >= start+right+1 {
+			right = p - start
+		}
+		if left == 0 && right == g.cols-1 {
+			break
+		}
+	}
+	return top, left, bottom - top + 1, right - left + 1, true
+}
+
+// countNeighbors returns the number of set cells among the 4 or 8 neighbors
+// of (r,c). Neighbors outside the grid count as unset.
+// Internal implementation - no validation.
+func (g *Grid) countNeighbors(r, c int, diagonal bool) int {
+	n := 0
+	for _, d := range neighborOffsets(diagonal) {
+		nr, nc := r+d[0], c+d[1]
+		if nr < 0 || nr >= g.rows || nc < 0 || nc >= g.cols {
+			continue
+		}
+		if g.B.test(g.rowStart(nr) + nc) {
+			n++
+		}
+	}
+	return n
+}
+
+// dilate returns a new grid in which a cell is set if it or any of its
+// neighbors is set in g.
+// Internal implementation - no validation.
+func (g *Grid) dilate(diagonal bool) *Grid {
+	return g.morph(diagonal, false)
+}
+
+// erode returns a new grid in which a cell is set only if it and all of its
+// neighbors are set in g. Neighbors outside the grid count as unset, so
+// border cells never survive.
+// Internal implementation - no validation.
+func (g *Grid) erode(diagonal bool) *Grid {
+	return g.morph(diagonal, true)
+}
+
+// morph applies one dilation (erode false) or erosion (erode true) step.
+//
+// Algorithm: each row is first combined with copies of itself shifted one
+// column in each direction (OR for dilation, AND for erosion); shifts fill
+// with zeros, so out-of-grid columns count as unset. Each output row then
+// combines the horizontal result of its own row with the rows above and
+// below: their horizontal results for 8-connectivity, their plain bits for
+// 4-connectivity. For erosion a missing row above or below clears the
+// output row. All combining is done with whole-row bitmap operations.
+// Internal implementation - no validation.
+func (g *Grid) morph(diagonal, erode bool) *Grid {
+	out := &Grid{
+		B:    New(uint(g.rows * g.cols)),
+		cols: g.cols,
+		rows: g.rows,
+	}
+	if g.rows == 0 || g.cols == 0 {
+		return out
+	}
+
+	combine := (*Bitmap).or
+	if erode {
+		combine = (*Bitmap).and
+	}
+
+	rows := make([]*Bitmap, g.rows)
+	horiz := make([]*Bitmap, g.rows)
+	for r := range g.rows {
+		rows[r] = g.row(r)
+		h := rows[r].Clone()
+		tmp := rows[r].Clone()
+		tmp.shiftUp(1)
+		combine(h, tmp)
+		tmp.copyFrom(rows[r])
+		tmp.shiftDown(1)
+		combine(h, tmp)
+		horiz[r] = h
+	}
+
+	vert := rows
+	if diagonal {
+		vert = horiz
+	}
+	for r := range g.rows {
+		acc := horiz[r].Clone()
+		for _, nr := range [2]int{r - 1, r + 1} {
+			if nr < 0 || nr >= g.rows {
+				if erode {
+					acc.clearAll()
+				}
+				continue
+			}
+			combine(acc, vert[nr])
+		}
+		out.setRow(r, acc)
+	}
+	return out
+}
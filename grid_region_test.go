@@ -0,0 +1,365 @@
+package btmp_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestGridMaxEmptyRectangle validates Grid.MaxEmptyRectangle().
+func TestGridMaxEmptyRectangle(t *testing.T) {
+	// bruteArea returns the maximum all-zero rectangle area by exhaustive search.
+	bruteArea := func(g *btmp.Grid) int {
+		best := 0
+		for r := range g.Rows() {
+			for c := range g.Cols() {
+				for h := 1; r+h <= g.Rows(); h++ {
+					for w := 1; c+w <= g.Cols(); w++ {
+						if h*w > best && g.RectZero(r, c, h, w) {
+							best = h * w
+						}
+					}
+				}
+			}
+		}
+		return best
+	}
+
+	t.Run("matches exhaustive search", func(t *testing.T) {
+		for _, seed := range []int{3, 5, 7} {
+			g := btmp.NewGridWithSize(9, 12)
+			for i := range g.B.Len() {
+				if (i*seed*2654435761)%17 < 4 {
+					g.B.SetBit(i)
+				}
+			}
+			r, c, h, w := g.MaxEmptyRectangle()
+			if want := bruteArea(g); h*w != want {
+				t.Errorf("seed %d: expected area %d, got %d", seed, want, h*w)
+			}
+			if h > 0 && !g.RectZero(r, c, h, w) {
+				t.Errorf("seed %d: rectangle (%d,%d,%d,%d) is not empty", seed, r, c, h, w)
+			}
+		}
+	})
+
+	t.Run("finds known rectangle", func(t *testing.T) {
+		g := btmp.NewGridWithSize(5, 6).SetRect(0, 0, 5, 6).ClearRect(1, 2, 3, 4).ClearRect(0, 0, 1, 1)
+		r, c, h, w := g.MaxEmptyRectangle()
+		if r != 1 || c != 2 || h != 3 || w != 4 {
+			t.Errorf("expected (1,2,3,4), got (%d,%d,%d,%d)", r, c, h, w)
+		}
+	})
+
+	t.Run("empty grid is one rectangle", func(t *testing.T) {
+		r, c, h, w := btmp.NewGridWithSize(3, 70).MaxEmptyRectangle()
+		if r != 0 || c != 0 || h != 3 || w != 70 {
+			t.Errorf("expected (0,0,3,70), got (%d,%d,%d,%d)", r, c, h, w)
+		}
+	})
+
+	t.Run("fully occupied returns zeros", func(t *testing.T) {
+		r, c, h, w := btmp.NewGridWithSize(3, 3).SetRect(0, 0, 3, 3).MaxEmptyRectangle()
+		if r != 0 || c != 0 || h != 0 || w != 0 {
+			t.Errorf("expected zeros, got (%d,%d,%d,%d)", r, c, h, w)
+		}
+	})
+}
+
+// TestGridLargestEmptySquare validates Grid.LargestEmptySquare().
+func TestGridLargestEmptySquare(t *testing.T) {
+	// bruteSquare returns the top-left-most largest all-zero square.
+	bruteSquare := func(g *btmp.Grid) (int, int, int) {
+		for s := min(g.Rows(), g.Cols()); s > 0; s-- {
+			for r := 0; r+s <= g.Rows(); r++ {
+				for c := 0; c+s <= g.Cols(); c++ {
+					if g.RectZero(r, c, s, s) {
+						return r, c, s
+					}
+				}
+			}
+		}
+		return 0, 0, 0
+	}
+
+	t.Run("matches exhaustive search", func(t *testing.T) {
+		for _, seed := range []int{3, 5, 7, 11} {
+			g := btmp.NewGridWithSize(10, 13)
+			for i := range g.B.Len() {
+				if (i*seed*2654435761)%23 < 4 {
+					g.B.SetBit(i)
+				}
+			}
+			r, c, size := g.LargestEmptySquare()
+			wr, wc, ws := bruteSquare(g)
+			if r != wr || c != wc || size != ws {
+				t.Errorf("seed %d: expected (%d,%d,%d), got (%d,%d,%d)", seed, wr, wc, ws, r, c, size)
+			}
+		}
+	})
+
+	t.Run("prefers squareness over area", func(t *testing.T) {
+		g := btmp.NewGridWithSize(4, 10).SetRect(0, 0, 4, 10).ClearRect(0, 0, 1, 10).ClearRect(1, 7, 3, 3)
+		r, c, size := g.LargestEmptySquare()
+		if r != 0 || c != 7 || size != 3 {
+			t.Errorf("expected (0,7,3), got (%d,%d,%d)", r, c, size)
+		}
+	})
+
+	t.Run("fully occupied returns size 0", func(t *testing.T) {
+		_, _, size := btmp.NewGridWithSize(3, 3).SetRect(0, 0, 3, 3).LargestEmptySquare()
+		if size != 0 {
+			t.Errorf("expected size 0, got %d", size)
+		}
+	})
+
+	t.Run("empty grid", func(t *testing.T) {
+		if _, _, size := btmp.NewGrid().LargestEmptySquare(); size != 0 {
+			t.Errorf("expected size 0, got %d", size)
+		}
+	})
+}
+
+// TestGridConnectedComponents validates Grid.ConnectedComponents().
+func TestGridConnectedComponents(t *testing.T) {
+	// Layout (# set):
+	//   # # . . #
+	//   . # . # .
+	//   . . . . #
+	//   # # # . #
+	g := btmp.NewGridWithSize(4, 5)
+	for _, rc := range [][2]int{{0, 0}, {0, 1}, {1, 1}, {0, 4}, {1, 3}, {2, 4}, {3, 4}, {3, 0}, {3, 1}, {3, 2}} {
+		g.B.SetBit(g.Index(rc[0], rc[1]))
+	}
+
+	t.Run("4-connectivity", func(t *testing.T) {
+		comps := g.ConnectedComponents(false)
+		want := [][]int{{0, 1, 6}, {4}, {8}, {14, 19}, {15, 16, 17}}
+		if len(comps) != len(want) {
+			t.Fatalf("expected %d components, got %d: %v", len(want), len(comps), comps)
+		}
+		for i := range want {
+			if !slices.Equal(comps[i], want[i]) {
+				t.Errorf("component %d: expected %v, got %v", i, want[i], comps[i])
+			}
+		}
+	})
+
+	t.Run("8-connectivity", func(t *testing.T) {
+		comps := g.ConnectedComponents(true)
+		want := [][]int{{0, 1, 6}, {4, 8, 14, 19}, {15, 16, 17}}
+		if len(comps) != len(want) {
+			t.Fatalf("expected %d components, got %d: %v", len(want), len(comps), comps)
+		}
+		for i := range want {
+			if !slices.Equal(comps[i], want[i]) {
+				t.Errorf("component %d: expected %v, got %v", i, want[i], comps[i])
+			}
+		}
+	})
+
+	t.Run("does not wrap across row boundaries", func(t *testing.T) {
+		g := btmp.NewGridWithSize(2, 3)
+		g.B.SetBit(g.Index(0, 2)).SetBit(g.Index(1, 0))
+		if n := len(g.ConnectedComponents(true)); n != 2 {
+			t.Errorf("expected 2 components, got %d", n)
+		}
+	})
+
+	t.Run("no set cells", func(t *testing.T) {
+		if comps := btmp.NewGridWithSize(3, 3).ConnectedComponents(true); len(comps) != 0 {
+			t.Errorf("expected no components, got %v", comps)
+		}
+	})
+}
+
+// TestGridFloodFill validates Grid.FloodFill().
+func TestGridFloodFill(t *testing.T) {
+	// ring builds a 6x6 grid with a closed ring around the 2x2 block at (2,2).
+	ring := func() *btmp.Grid {
+		return btmp.NewGridWithSize(6, 6).SetRect(1, 1, 4, 4).ClearRect(2, 2, 2, 2)
+	}
+
+	t.Run("fills enclosed region", func(t *testing.T) {
+		g := ring().FloodFill(2, 2, true)
+		if !g.AllRect(1, 1, 4, 4) || g.B.Count() != 16 {
+			t.Errorf("expected filled 4x4 block, got count=%d", g.B.Count())
+		}
+	})
+
+	t.Run("fills outside region without crossing ring", func(t *testing.T) {
+		g := ring().FloodFill(0, 0, true)
+		if g.B.Count() != 36-4 || g.AnyRect(2, 2, 2, 2) {
+			t.Errorf("expected only inner hole clear, got count=%d", g.B.Count())
+		}
+	})
+
+	t.Run("clears region", func(t *testing.T) {
+		g := ring().FloodFill(1, 1, false)
+		if g.B.Any() {
+			t.Errorf("expected ring cleared, got count=%d", g.B.Count())
+		}
+	})
+
+	t.Run("no-op when seed already matches", func(t *testing.T) {
+		g := ring().FloodFill(1, 1, true)
+		if !g.Equal(ring()) {
+			t.Error("expected grid unchanged")
+		}
+	})
+
+	t.Run("handles large region", func(t *testing.T) {
+		g := btmp.NewGridWithSize(300, 300).FloodFill(150, 150, true)
+		if !g.B.All() {
+			t.Error("expected grid fully set")
+		}
+	})
+
+	t.Run("panics on out-of-bounds seed", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds seed")
+			}
+		}()
+		ring().FloodFill(6, 0, true)
+	})
+}
+
+// TestGridBoundingBox validates Grid.BoundingBox().
+func TestGridBoundingBox(t *testing.T) {
+	tests := []struct {
+		name       string
+		g          *btmp.Grid
+		r, c, h, w int
+	}{
+		{"single cell", btmp.NewGridWithSize(5, 5).SetRect(2, 3, 1, 1), 2, 3, 1, 1},
+		{"spread cells", btmp.NewGridWithSize(6, 80).SetRect(1, 70, 1, 1).SetRect(3, 5, 1, 1).SetRect(4, 40, 1, 1), 1, 5, 4, 66},
+		{"full grid", btmp.NewGridWithSize(3, 4).SetRect(0, 0, 3, 4), 0, 0, 3, 4},
+		{"single column", btmp.NewGridWithSize(4, 70).FillCol(66), 0, 66, 4, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, c, h, w, ok := tt.g.BoundingBox()
+			if !ok || r != tt.r || c != tt.c || h != tt.h || w != tt.w {
+				t.Errorf("expected (%d,%d,%d,%d,true), got (%d,%d,%d,%d,%v)", tt.r, tt.c, tt.h, tt.w, r, c, h, w, ok)
+			}
+		})
+	}
+
+	t.Run("no set cells", func(t *testing.T) {
+		if _, _, _, _, ok := btmp.NewGridWithSize(3, 3).BoundingBox(); ok {
+			t.Error("expected ok=false")
+		}
+		if _, _, _, _, ok := btmp.NewGrid().BoundingBox(); ok {
+			t.Error("expected ok=false for empty grid")
+		}
+	})
+}
+
+// TestGridCountNeighbors validates Grid.CountNeighbors().
+func TestGridCountNeighbors(t *testing.T) {
+	full := btmp.NewGridWithSize(3, 3).SetRect(0, 0, 3, 3)
+	tests := []struct {
+		name         string
+		r, c         int
+		want4, want8 int
+	}{
+		{"center", 1, 1, 4, 8},
+		{"corner", 0, 0, 2, 3},
+		{"edge", 0, 1, 3, 5},
+		{"opposite corner", 2, 2, 2, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := full.CountNeighbors(tt.r, tt.c, false); got != tt.want4 {
+				t.Errorf("4-neighbors: expected %d, got %d", tt.want4, got)
+			}
+			if got := full.CountNeighbors(tt.r, tt.c, true); got != tt.want8 {
+				t.Errorf("8-neighbors: expected %d, got %d", tt.want8, got)
+			}
+		})
+	}
+
+	t.Run("does not wrap across rows", func(t *testing.T) {
+		g := btmp.NewGridWithSize(2, 3)
+		g.B.SetBit(g.Index(0, 2))
+		if got := g.CountNeighbors(1, 0, true); got != 0 {
+			t.Errorf("expected 0, got %d", got)
+		}
+	})
+
+	t.Run("panics on out-of-bounds cell", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for out-of-bounds cell")
+			}
+		}()
+		full.CountNeighbors(3, 0, false)
+	})
+}
+
+// TestGridMorphology validates Grid.Dilate() and Grid.Erode() against a
+// per-cell reference built from CountNeighbors.
+func TestGridMorphology(t *testing.T) {
+	t.Run("single cell", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 3)
+		g.B.SetBit(g.Index(1, 1))
+
+		if got := g.Dilate(false).B.Count(); got != 5 {
+			t.Errorf("4-dilate: expected 5 cells, got %d", got)
+		}
+		if got := g.Dilate(true).B.Count(); got != 9 {
+			t.Errorf("8-dilate: expected 9 cells, got %d", got)
+		}
+		if got := g.Erode(false).B.Count(); got != 0 {
+			t.Errorf("erode: expected 0 cells, got %d", got)
+		}
+		if got := g.B.Count(); got != 1 {
+			t.Errorf("source modified: expected 1 cell, got %d", got)
+		}
+	})
+
+	t.Run("full grid erodes border", func(t *testing.T) {
+		g := btmp.NewGridWithSize(4, 5).SetRect(0, 0, 4, 5)
+		e := g.Erode(true)
+		if r, c, h, w, ok := e.BoundingBox(); !ok || r != 1 || c != 1 || h != 2 || w != 3 {
+			t.Errorf("expected interior (1,1,2,3), got (%d,%d,%d,%d,%v)", r, c, h, w, ok)
+		}
+		if got := e.B.Count(); got != 6 {
+			t.Errorf("expected 6 cells, got %d", got)
+		}
+	})
+
+	t.Run("matches reference", func(t *testing.T) {
+		g := sampleGrid(6, 70)
+		g.SetRect(1, 60, 4, 8)
+		for _, diagonal := range []bool{false, true} {
+			all := 4
+			if diagonal {
+				all = 8
+			}
+			d := g.Dilate(diagonal)
+			e := g.Erode(diagonal)
+			for r := range g.Rows() {
+				for c := range g.Cols() {
+					set := g.B.Test(g.Index(r, c))
+					n := g.CountNeighbors(r, c, diagonal)
+					if want := set || n > 0; d.B.Test(d.Index(r, c)) != want {
+						t.Errorf("dilate(%v) (%d,%d): expected %v", diagonal, r, c, want)
+					}
+					if want := set && n == all; e.B.Test(e.Index(r, c)) != want {
+						t.Errorf("erode(%v) (%d,%d): expected %v", diagonal, r, c, want)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("empty grid", func(t *testing.T) {
+		g := btmp.NewGridWithSize(0, 4)
+		if got := g.Dilate(true); got.Rows() != 0 || got.Cols() != 4 {
+			t.Errorf("expected 0x4, got %dx%d", got.Rows(), got.Cols())
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package btmp
+
+// row returns a new Cols()-bit bitmap holding a copy of row r.
+// Internal implementation - no validation.
+func (g *Grid) row(r int) *Bitmap {
+	return g.B.slice(g.rowStart(r), g.cols)
+}
+
+// col returns a new Rows()-bit bitmap holding a copy of column c, read with
+// stride Cols().
+// Internal implementation - no validation.
+func (g *Grid) col(c int) *Bitmap {
+	out := New(uint(g.rows))
+	for r := range g.rows {
+		if g.B.test(g.rowStart(r) + c) {
+			out.setBit(r)
+		}
+	}
+	return out
+}
+
+// setRow overwrites row r with the Cols() bits of src.
+// Internal implementation - no validation.
+func (g *Grid) setRow(r int, src *Bitmap) {
+	g.B.copyRange(src, 0, g.rowStart(r), g.cols)
+}
+
+// setCol overwrites column c with the Rows() bits of src, writing one bit
+// per row at stride Cols().
+// Internal implementation - no validation.
+func (g *Grid) setCol(c int, src *Bitmap) {
+	for r := range g.rows {
+		if src.test(r) {
+			g.B.setBit(g.rowStart(r) + c)
+		} else {
+			g.B.clearBit(g.rowStart(r) + c)
+		}
+	}
+}
+
+// clearRow clears every cell in row r.
+// Internal implementation - no validation.
+func (g *Grid) clearRow(r int) {
+	g.B.clearRange(g.rowStart(r), g.cols)
+}
+
+// clearCol clears every cell in column c, one bit per row at stride Cols().
+// Internal implementation - no validation.
+func (g *Grid) clearCol(c int) {
+	for r := range g.rows {
+		g.B.clearBit(g.rowStart(r) + c)
+	}
+}
+
+// fillCol sets every cell in column c, one bit per row at stride Cols().
+// Internal implementation - no validation.
+func (g *Grid) fillCol(c int) {
+	for r := range g.rows {
+		g.B.setBit(g.rowStart(r) + c)
+	}
+}
+
+// swapRows exchanges the cells of rows r1 and r2.
+// Internal implementation - no validation.
+func (g *Grid) swapRows(r1, r2 int) {
+	if r1 == r2 || g.cols == 0 {
+		return
+	}
+	g.swapRowsVia(New(uint(g.cols)), r1, r2)
+}
+
+// swapRowsVia exchanges rows r1 and r2 word-wise through tmp, a scratch
+// bitmap of at least Cols() bits.
+// Internal implementation - no validation.
+func (g *Grid) swapRowsVia(tmp *Bitmap, r1, r2 int) {
+	tmp.copyRange(g.B, g.rowStart(r1), 0, g.cols)
+	g.B.copyRange(g.B, g.rowStart(r2), g.rowStart(r1), g.cols)
+	g.B.copyRange(tmp, 0, g.rowStart(r2), g.cols)
+}
+
+// swapCols exchanges the cells of columns c1 and c2, one row at a time.
+// Internal implementation - no validation.
+func (g *Grid) swapCols(c1, c2 int) {
+	if c1 == c2 {
+		return
+	}
+	for r := range g.rows {
+		i, j := g.rowStart(r)+c1, g.rowStart(r)+c2
+		if g.B.test(i) != g.B.test(j) {
+			g.B.flipBit(i)
+			g.B.flipBit(j)
+		}
+	}
+}
+
+// copyRow overwrites row dstR with a copy of row srcR.
+// Internal implementation - no validation.
+func (g *Grid) copyRow(srcR, dstR int) {
+	g.B.copyRange(g.B, g.rowStart(srcR), g.rowStart(dstR), g.cols)
+}
@@ -0,0 +1,297 @@
+package btmp_test
+
+import (
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// TestGridRowCol validates Grid.Row() and Grid.Col() extraction.
+func TestGridRowCol(t *testing.T) {
+	g := sampleGrid(4, 70)
+
+	for r := range g.Rows() {
+		row := g.Row(r)
+		if row.Len() != g.Cols() {
+			t.Fatalf("Row(%d): expected len=%d, got %d", r, g.Cols(), row.Len())
+		}
+		for c := range g.Cols() {
+			if row.Test(c) != g.B.Test(g.Index(r, c)) {
+				t.Fatalf("Row(%d): bit %d mismatch", r, c)
+			}
+		}
+	}
+
+	for c := range g.Cols() {
+		col := g.Col(c)
+		if col.Len() != g.Rows() {
+			t.Fatalf("Col(%d): expected len=%d, got %d", c, g.Rows(), col.Len())
+		}
+		for r := range g.Rows() {
+			if col.Test(r) != g.B.Test(g.Index(r, c)) {
+				t.Fatalf("Col(%d): bit %d mismatch", c, r)
+			}
+		}
+	}
+
+	t.Run("result is independent", func(t *testing.T) {
+		g := btmp.NewGridWithSize(2, 2)
+		g.Row(0).SetAll()
+		g.Col(0).SetAll()
+		if g.B.Any() {
+			t.Error("expected grid unchanged")
+		}
+	})
+
+	t.Run("zero-length rows and columns", func(t *testing.T) {
+		if btmp.NewGridWithSize(2, 0).Row(1).Len() != 0 {
+			t.Error("expected empty row bitmap")
+		}
+		if btmp.NewGridWithSize(0, 2).Col(1).Len() != 0 {
+			t.Error("expected empty column bitmap")
+		}
+	})
+
+	t.Run("panics on out-of-bounds row", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for r >= Rows()")
+			}
+		}()
+		g.Row(4)
+	})
+
+	t.Run("panics on out-of-bounds column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for c >= Cols()")
+			}
+		}()
+		g.Col(-1)
+	})
+}
+
+// TestGridSetRowCol validates Grid.SetRow() and Grid.SetCol() writes.
+func TestGridSetRowCol(t *testing.T) {
+	t.Run("SetRow overwrites only the target row", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 70).SetRect(0, 0, 3, 70)
+		src := btmp.New(70).SetRange(60, 5)
+		g.SetRow(1, src)
+		if !g.Row(1).Equal(src) {
+			t.Error("expected row 1 to equal src")
+		}
+		if g.CountRow(0) != 70 || g.CountRow(2) != 70 {
+			t.Error("expected other rows untouched")
+		}
+	})
+
+	t.Run("SetCol overwrites only the target column", func(t *testing.T) {
+		g := btmp.NewGridWithSize(5, 3).SetRect(0, 0, 5, 3)
+		src := btmp.New(5).SetBit(1).SetBit(4)
+		g.SetCol(1, src)
+		if !g.Col(1).Equal(src) {
+			t.Error("expected column 1 to equal src")
+		}
+		if g.CountCol(0) != 5 || g.CountCol(2) != 5 {
+			t.Error("expected other columns untouched")
+		}
+	})
+
+	t.Run("round-trips extracted lines", func(t *testing.T) {
+		g := sampleGrid(4, 6)
+		h := btmp.NewGridWithSize(4, 6)
+		for r := range 4 {
+			h.SetRow(r, g.Row(r))
+		}
+		if !h.Equal(g) {
+			t.Error("expected rows to round-trip")
+		}
+		v := btmp.NewGridWithSize(4, 6)
+		for c := range 6 {
+			v.SetCol(c, g.Col(c))
+		}
+		if !v.Equal(g) {
+			t.Error("expected columns to round-trip")
+		}
+	})
+
+	t.Run("panics on row length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for length mismatch")
+			}
+		}()
+		btmp.NewGridWithSize(3, 4).SetRow(0, btmp.New(3))
+	})
+
+	t.Run("panics on column length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for length mismatch")
+			}
+		}()
+		btmp.NewGridWithSize(3, 4).SetCol(0, btmp.New(4))
+	})
+
+	t.Run("panics on out-of-bounds index", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for r >= Rows()")
+			}
+		}()
+		btmp.NewGridWithSize(3, 4).SetRow(3, btmp.New(4))
+	})
+}
+
+// TestGridClearRowCol validates Grid.ClearRow() and Grid.ClearCol().
+func TestGridClearRowCol(t *testing.T) {
+	t.Run("ClearRow clears only the target row", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 70).SetRect(0, 0, 3, 70).ClearRow(1)
+		if g.CountRow(1) != 0 || g.B.Count() != 140 {
+			t.Errorf("expected row 1 cleared, got count=%d", g.B.Count())
+		}
+	})
+
+	t.Run("ClearCol clears only the target column", func(t *testing.T) {
+		g := btmp.NewGridWithSize(4, 3).SetRect(0, 0, 4, 3).ClearCol(2)
+		if g.CountCol(2) != 0 || g.B.Count() != 8 {
+			t.Errorf("expected column 2 cleared, got count=%d", g.B.Count())
+		}
+	})
+
+	t.Run("zero-length lines are no-ops", func(t *testing.T) {
+		btmp.NewGridWithSize(2, 0).ClearRow(1)
+		btmp.NewGridWithSize(0, 2).ClearCol(1)
+	})
+
+	t.Run("panics on out-of-bounds row", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for r >= Rows()")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).ClearRow(3)
+	})
+
+	t.Run("panics on out-of-bounds column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for c >= Cols()")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).ClearCol(3)
+	})
+}
+
+// TestGridFillCol validates Grid.FillCol().
+func TestGridFillCol(t *testing.T) {
+	t.Run("sets only the target column", func(t *testing.T) {
+		g := btmp.NewGridWithSize(4, 70).FillCol(65)
+		if !g.AllCol(65) || g.B.Count() != 4 {
+			t.Errorf("expected column 65 filled, got count=%d", g.B.Count())
+		}
+		g.ClearCol(65)
+		if g.B.Any() {
+			t.Error("expected ClearCol to undo FillCol")
+		}
+	})
+
+	t.Run("no-op without rows", func(t *testing.T) {
+		g := btmp.NewGridWithSize(0, 3).FillCol(2)
+		if g.B.Len() != 0 {
+			t.Errorf("expected len=0, got %d", g.B.Len())
+		}
+	})
+
+	t.Run("panics on out-of-bounds column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for c >= Cols()")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).FillCol(3)
+	})
+}
+
+// TestGridSwapRowsCols validates Grid.SwapRows() and Grid.SwapCols().
+func TestGridSwapRowsCols(t *testing.T) {
+	t.Run("SwapRows exchanges rows", func(t *testing.T) {
+		src := sampleGrid(4, 70)
+		g := src.Clone().SwapRows(0, 3)
+		if !g.Row(0).Equal(src.Row(3)) || !g.Row(3).Equal(src.Row(0)) {
+			t.Error("expected rows 0 and 3 exchanged")
+		}
+		if !g.Row(1).Equal(src.Row(1)) || g.B.Count() != src.B.Count() {
+			t.Error("expected other rows and count preserved")
+		}
+		if !g.SwapRows(3, 0).Equal(src) {
+			t.Error("expected second swap to restore grid")
+		}
+	})
+
+	t.Run("SwapCols exchanges columns", func(t *testing.T) {
+		src := sampleGrid(5, 7)
+		g := src.Clone().SwapCols(1, 6)
+		if !g.Col(1).Equal(src.Col(6)) || !g.Col(6).Equal(src.Col(1)) {
+			t.Error("expected columns 1 and 6 exchanged")
+		}
+		if !g.Col(0).Equal(src.Col(0)) || g.B.Count() != src.B.Count() {
+			t.Error("expected other columns and count preserved")
+		}
+	})
+
+	t.Run("same index is a no-op", func(t *testing.T) {
+		src := sampleGrid(3, 3)
+		if !src.Clone().SwapRows(1, 1).SwapCols(2, 2).Equal(src) {
+			t.Error("expected grid unchanged")
+		}
+	})
+
+	t.Run("panics on out-of-bounds row", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for r2 >= Rows()")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).SwapRows(0, 3)
+	})
+
+	t.Run("panics on out-of-bounds column", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for negative c1")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).SwapCols(-1, 0)
+	})
+}
+
+// TestGridCopyRow validates Grid.CopyRow().
+func TestGridCopyRow(t *testing.T) {
+	t.Run("duplicates a row", func(t *testing.T) {
+		src := sampleGrid(4, 70)
+		g := src.Clone().CopyRow(1, 3)
+		if !g.Row(3).Equal(src.Row(1)) || !g.Row(1).Equal(src.Row(1)) {
+			t.Error("expected row 3 to equal row 1")
+		}
+		if !g.Row(0).Equal(src.Row(0)) || !g.Row(2).Equal(src.Row(2)) {
+			t.Error("expected other rows untouched")
+		}
+	})
+
+	t.Run("same row is a no-op", func(t *testing.T) {
+		src := sampleGrid(3, 5)
+		if !src.Clone().CopyRow(2, 2).Equal(src) {
+			t.Error("expected grid unchanged")
+		}
+	})
+
+	t.Run("panics on out-of-bounds row", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for dstR >= Rows()")
+			}
+		}()
+		btmp.NewGridWithSize(3, 3).CopyRow(0, 3)
+	})
+}
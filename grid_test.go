@@ -179,3 +179,93 @@ func TestGridIndex(t *testing.T) {
 		g.Index(0, -1)
 	})
 }
+
+// TestGridClone validates Grid.Clone() deep copy behavior.
+func TestGridClone(t *testing.T) {
+	t.Run("copies dimensions and cells", func(t *testing.T) {
+		g := btmp.NewGridWithSize(4, 9).SetRect(1, 2, 2, 5)
+		c := g.Clone()
+		if c.Rows() != 4 || c.Cols() != 9 {
+			t.Errorf("expected 4x9, got %dx%d", c.Rows(), c.Cols())
+		}
+		if c.Print() != g.Print() {
+			t.Errorf("expected identical cells, got:\n%s", c.Print())
+		}
+	})
+
+	t.Run("clone is independent", func(t *testing.T) {
+		g := btmp.NewGridWithSize(3, 3)
+		c := g.Clone()
+		c.SetRect(0, 0, 3, 3)
+		if g.B.Any() {
+			t.Error("expected original grid unchanged")
+		}
+		g.SetRect(1, 1, 1, 1)
+		if c.B.Count() != 9 {
+			t.Errorf("expected clone count=9, got %d", c.B.Count())
+		}
+	})
+
+	t.Run("drops surplus capacity", func(t *testing.T) {
+		g := btmp.NewGridWithSize(2, 10)
+		g.B.Reserve(10000)
+		c := g.Clone()
+		if c.B.Cap() != 64 {
+			t.Errorf("expected cap=64, got %d", c.B.Cap())
+		}
+	})
+
+	t.Run("clones empty grids", func(t *testing.T) {
+		for _, g := range []*btmp.Grid{btmp.NewGrid(), btmp.NewGridWithSize(0, 5), btmp.NewGridWithSize(5, 0)} {
+			c := g.Clone()
+			if c.Rows() != g.Rows() || c.Cols() != g.Cols() || c.B.Len() != 0 {
+				t.Errorf("expected %dx%d empty clone, got %dx%d len=%d", g.Rows(), g.Cols(), c.Rows(), c.Cols(), c.B.Len())
+			}
+		}
+	})
+}
+
+// TestGridEqual validates Grid.Equal() comparison.
+func TestGridEqual(t *testing.T) {
+	t.Run("equal grids", func(t *testing.T) {
+		a := btmp.NewGridWithSize(3, 7).SetRect(1, 1, 2, 4)
+		b := btmp.NewGridWithSize(3, 7).SetRect(1, 1, 2, 4)
+		if !a.Equal(b) {
+			t.Error("expected grids to be equal")
+		}
+	})
+
+	t.Run("different cells", func(t *testing.T) {
+		a := btmp.NewGridWithSize(3, 7).SetRect(1, 1, 2, 4)
+		b := btmp.NewGridWithSize(3, 7).SetRect(1, 1, 2, 3)
+		if a.Equal(b) {
+			t.Error("expected grids to differ")
+		}
+	})
+
+	t.Run("same bit count with different dimensions", func(t *testing.T) {
+		a := btmp.NewGridWithSize(2, 6)
+		b := btmp.NewGridWithSize(3, 4)
+		if a.Equal(b) {
+			t.Error("expected grids with different dimensions to differ")
+		}
+	})
+
+	t.Run("empty grids", func(t *testing.T) {
+		if !btmp.NewGrid().Equal(btmp.NewGridWithSize(0, 0)) {
+			t.Error("expected 0x0 grids to be equal")
+		}
+		if btmp.NewGridWithSize(0, 3).Equal(btmp.NewGrid()) {
+			t.Error("expected 0x3 and 0x0 grids to differ")
+		}
+	})
+
+	t.Run("panics on nil other", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil other")
+			}
+		}()
+		btmp.NewGrid().Equal(nil)
+	})
+}
@@ -0,0 +1,76 @@
+package btmp
+
+// remap returns a new rows×cols grid in which each set cell (r,c) of g is set
+// at fn(r, c). Only set cells are visited.
+// Internal implementation - no validation, fn must map into bounds.
+func (g *Grid) remap(rows, cols int, fn func(r, c int) (int, int)) *Grid {
+	out := &Grid{
+		B:    New(uint(rows * cols)),
+		cols: cols,
+		rows: rows,
+	}
+	for i := range g.B.bitsSeq(true) {
+		r, c := fn(i/g.cols, i%g.cols)
+		out.B.setBit(r*cols + c)
+	}
+	return out
+}
+
+// transpose returns a new Cols()×Rows() grid with cell (c,r) = g(r,c).
+// Internal implementation - no validation.
+func (g *Grid) transpose() *Grid {
+	return g.remap(g.cols, g.rows, func(r, c int) (int, int) {
+		return c, r
+	})
+}
+
+// flipHorizontal reverses the column order within each row.
+// Internal implementation - no validation.
+func (g *Grid) flipHorizontal() {
+	if g.cols < 2 {
+		return
+	}
+	for r := range g.rows {
+		g.B.reverseRange(g.rowStart(r), g.cols)
+	}
+}
+
+// flipVertical reverses the row order by swapping row spans through a
+// one-row temporary.
+// Internal implementation - no validation.
+func (g *Grid) flipVertical() {
+	if g.rows < 2 || g.cols == 0 {
+		return
+	}
+	tmp := New(uint(g.cols))
+	for top, bottom := 0, g.rows-1; top < bottom; top, bottom = top+1, bottom-1 {
+		g.swapRowsVia(tmp, top, bottom)
+	}
+}
+
+// rotate90 returns a new Cols()×Rows() grid rotated clockwise:
+// cell (r,c) maps to (c, Rows()-1-r).
+// Internal implementation - no validation.
+func (g *Grid) rotate90() *Grid {
+	return g.remap(g.cols, g.rows, func(r, c int) (int, int) {
+		return c, g.rows - 1 - r
+	})
+}
+
+// rotate180 returns a new Rows()×Cols() grid rotated a half turn:
+// cell (r,c) maps to (Rows()-1-r, Cols()-1-c).
+// Internal implementation - no validation.
+func (g *Grid) rotate180() *Grid {
+	return g.remap(g.rows, g.cols, func(r, c int) (int, int) {
+		return g.rows - 1 - r, g.cols - 1 - c
+	})
+}
+
+// rotate270 returns a new Cols()×Rows() grid rotated counter-clockwise:
+// cell (r,c) maps to (Cols()-1-c, r).
+// Internal implementation - no validation.
+func (g *Grid) rotate270() *Grid {
+	return g.remap(g.cols, g.rows, func(r, c int) (int, int) {
+		return g.cols - 1 - c, r
+	})
+}
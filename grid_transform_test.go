@@ -0,0 +1,136 @@
+package btmp_test
+
+import (
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// sampleGrid returns a rows×cols grid with an asymmetric pattern of set cells.
+func sampleGrid(rows, cols int) *btmp.Grid {
+	g := btmp.NewGridWithSize(rows, cols)
+	for r := range rows {
+		for c := range cols {
+			if (r*cols+c)%3 == 0 || c == cols-1 {
+				g.B.SetBit(g.Index(r, c))
+			}
+		}
+	}
+	return g
+}
+
+// TestGridTranspose validates Grid.Transpose() remapping.
+func TestGridTranspose(t *testing.T) {
+	for _, dim := range [][2]int{{3, 5}, {5, 3}, {4, 70}, {1, 1}} {
+		g := sampleGrid(dim[0], dim[1])
+		before := g.Clone()
+		tr := g.Transpose()
+
+		if tr.Rows() != g.Cols() || tr.Cols() != g.Rows() {
+			t.Fatalf("%dx%d: expected %dx%d, got %dx%d", dim[0], dim[1], g.Cols(), g.Rows(), tr.Rows(), tr.Cols())
+		}
+		for r := range g.Rows() {
+			for c := range g.Cols() {
+				if tr.B.Test(tr.Index(c, r)) != g.B.Test(g.Index(r, c)) {
+					t.Fatalf("%dx%d: cell (%d,%d) mismatch", dim[0], dim[1], r, c)
+				}
+			}
+		}
+		if !g.Equal(before) {
+			t.Errorf("%dx%d: expected source unchanged", dim[0], dim[1])
+		}
+		if !tr.Transpose().Equal(g) {
+			t.Errorf("%dx%d: expected double transpose to round-trip", dim[0], dim[1])
+		}
+	}
+
+	t.Run("empty dimensions", func(t *testing.T) {
+		tr := btmp.NewGridWithSize(0, 4).Transpose()
+		if tr.Rows() != 4 || tr.Cols() != 0 || tr.B.Len() != 0 {
+			t.Errorf("expected 4x0, got %dx%d", tr.Rows(), tr.Cols())
+		}
+	})
+}
+
+// TestGridFlip validates Grid.FlipHorizontal() and Grid.FlipVertical().
+func TestGridFlip(t *testing.T) {
+	for _, dim := range [][2]int{{3, 5}, {4, 4}, {5, 70}, {1, 1}} {
+		rows, cols := dim[0], dim[1]
+		src := sampleGrid(rows, cols)
+
+		h := src.Clone().FlipHorizontal()
+		v := src.Clone().FlipVertical()
+		for r := range rows {
+			for c := range cols {
+				want := src.B.Test(src.Index(r, c))
+				if h.B.Test(h.Index(r, cols-1-c)) != want {
+					t.Fatalf("%dx%d: FlipHorizontal cell (%d,%d) mismatch", rows, cols, r, c)
+				}
+				if v.B.Test(v.Index(rows-1-r, c)) != want {
+					t.Fatalf("%dx%d: FlipVertical cell (%d,%d) mismatch", rows, cols, r, c)
+				}
+			}
+		}
+		if h.B.Count() != src.B.Count() || v.B.Count() != src.B.Count() {
+			t.Errorf("%dx%d: expected count preserved", rows, cols)
+		}
+		if !h.FlipHorizontal().Equal(src) || !v.FlipVertical().Equal(src) {
+			t.Errorf("%dx%d: expected double flip to round-trip", rows, cols)
+		}
+	}
+
+	t.Run("empty grids", func(t *testing.T) {
+		for _, g := range []*btmp.Grid{btmp.NewGrid(), btmp.NewGridWithSize(0, 3), btmp.NewGridWithSize(3, 0)} {
+			g.FlipHorizontal().FlipVertical()
+			if g.B.Len() != 0 {
+				t.Errorf("expected empty grid, got len=%d", g.B.Len())
+			}
+		}
+	})
+}
+
+// TestGridRotate validates Grid.Rotate90(), Rotate180(), and Rotate270().
+func TestGridRotate(t *testing.T) {
+	for _, dim := range [][2]int{{3, 5}, {4, 4}, {2, 70}, {1, 1}} {
+		rows, cols := dim[0], dim[1]
+		g := sampleGrid(rows, cols)
+		before := g.Clone()
+
+		r90, r180, r270 := g.Rotate90(), g.Rotate180(), g.Rotate270()
+		if r90.Rows() != cols || r90.Cols() != rows || r270.Rows() != cols || r270.Cols() != rows {
+			t.Fatalf("%dx%d: expected quarter turns to be %dx%d", rows, cols, cols, rows)
+		}
+		if r180.Rows() != rows || r180.Cols() != cols {
+			t.Fatalf("%dx%d: expected half turn to keep dimensions", rows, cols)
+		}
+
+		for r := range rows {
+			for c := range cols {
+				want := g.B.Test(g.Index(r, c))
+				if r90.B.Test(r90.Index(c, rows-1-r)) != want {
+					t.Fatalf("%dx%d: Rotate90 cell (%d,%d) mismatch", rows, cols, r, c)
+				}
+				if r180.B.Test(r180.Index(rows-1-r, cols-1-c)) != want {
+					t.Fatalf("%dx%d: Rotate180 cell (%d,%d) mismatch", rows, cols, r, c)
+				}
+				if r270.B.Test(r270.Index(cols-1-c, r)) != want {
+					t.Fatalf("%dx%d: Rotate270 cell (%d,%d) mismatch", rows, cols, r, c)
+				}
+			}
+		}
+
+		if !r90.Rotate270().Equal(g) || !r180.Rotate180().Equal(g) || !r90.Rotate90().Equal(r180) {
+			t.Errorf("%dx%d: expected rotations to compose", rows, cols)
+		}
+		if !g.Equal(before) {
+			t.Errorf("%dx%d: expected source unchanged", rows, cols)
+		}
+	}
+
+	t.Run("empty dimensions", func(t *testing.T) {
+		r := btmp.NewGridWithSize(3, 0).Rotate90()
+		if r.Rows() != 0 || r.Cols() != 3 || r.B.Len() != 0 {
+			t.Errorf("expected 0x3, got %dx%d", r.Rows(), r.Cols())
+		}
+	})
+}
@@ -16,6 +16,7 @@ func (g *Grid) validateCoordinate(r, c int) error {
 			Field:   "r",
 			Value:   fmt.Sprintf("r=%d, rows=%d", r, g.rows),
 			Message: "out of bounds",
+			Err:     ErrOutOfBounds,
 		}
 	}
 	if c >= g.cols {
@@ -23,6 +24,7 @@ func (g *Grid) validateCoordinate(r, c int) error {
 			Field:   "c",
 			Value:   fmt.Sprintf("c=%d, cols=%d", c, g.cols),
 			Message: "out of bounds",
+			Err:     ErrOutOfBounds,
 		}
 	}
 	return nil
@@ -46,6 +48,7 @@ func (g *Grid) validateRect(r, c, h, w int) error {
 			Field:   "rectangle",
 			Value:   fmt.Sprintf("r=%d, h=%d, rows=%d", r, h, g.rows),
 			Message: "exceeds rows",
+			Err:     ErrOutOfBounds,
 		}
 	}
 	if c+w > g.cols {
@@ -53,6 +56,215 @@ func (g *Grid) validateRect(r, c, h, w int) error {
 			Field:   "rectangle",
 			Value:   fmt.Sprintf("c=%d, w=%d, cols=%d", c, w, g.cols),
 			Message: "exceeds columns",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// validateRow validates that r is a row index within [0, g.Rows()).
+// Returns ValidationError if r < 0 or r >= g.Rows().
+func (g *Grid) validateRow(r int) error {
+	if err := validateNonNegative(r, "r"); err != nil {
+		return err
+	}
+	if r >= g.rows {
+		return &ValidationError{
+			Field:   "r",
+			Value:   fmt.Sprintf("r=%d, rows=%d", r, g.rows),
+			Message: "out of bounds",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// validateCol validates that c is a column index within [0, g.Cols()).
+// Returns ValidationError if c < 0 or c >= g.Cols().
+func (g *Grid) validateCol(c int) error {
+	if err := validateNonNegative(c, "c"); err != nil {
+		return err
+	}
+	if c >= g.cols {
+		return &ValidationError{
+			Field:   "c",
+			Value:   fmt.Sprintf("c=%d, cols=%d", c, g.cols),
+			Message: "out of bounds",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// validateLineLength validates that src is non-nil and holds exactly n bits,
+// the length of the row or column it is written to.
+// Returns ValidationError if src is nil or src.Len() != n.
+func validateLineLength(src *Bitmap, n int) error {
+	if err := validateNotNil(src, "src"); err != nil {
+		return err
+	}
+	if src.lenBits != n {
+		return &ValidationError{
+			Field:   "src",
+			Value:   fmt.Sprintf("len=%d, want=%d", src.lenBits, n),
+			Message: "length mismatch",
+			Err:     ErrLengthMismatch,
+		}
+	}
+	return nil
+}
+
+// validateAt validates an insertion or removal index against a dimension.
+// Returns ValidationError if at < 0 or at >= limit.
+func validateAt(at, limit int, dim string) error {
+	if err := validateNonNegative(at, "at"); err != nil {
+		return err
+	}
+	if at >= limit {
+		return &ValidationError{
+			Field:   "at",
+			Value:   fmt.Sprintf("at=%d, %s=%d", at, dim, limit),
+			Message: "out of bounds",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// validateShrink validates that delta is non-negative and at most the current
+// dimension size n. Returns ValidationError if delta < 0 or delta > n.
+func validateShrink(delta, n int, dim string) error {
+	if err := validateNonNegative(delta, "delta"); err != nil {
+		return err
+	}
+	if delta > n {
+		return &ValidationError{
+			Field:   "delta",
+			Value:   fmt.Sprintf("delta=%d, %s=%d", delta, dim, n),
+			Message: "exceeds " + dim,
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// validateSameShape validates that other is non-nil and has the same
+// Rows() and Cols() as g. Grids of equal bit length but different shape
+// are rejected. Returns ValidationError on nil or mismatch.
+func (g *Grid) validateSameShape(other *Grid) error {
+	if err := validateNotNil(other, "other"); err != nil {
+		return err
+	}
+	if g.rows != other.rows || g.cols != other.cols {
+		return &ValidationError{
+			Field:   "shape",
+			Value:   fmt.Sprintf("a=%dx%d, b=%dx%d", g.rows, g.cols, other.rows, other.cols),
+			Message: "grids must have same dimensions",
+			Err:     ErrLengthMismatch,
+		}
+	}
+	return nil
+}
+
+// validateOverlay validates that src is non-nil and fits entirely within g
+// when its top-left cell is placed at (dstR,dstC). An empty src fits anywhere
+// in [0, Rows()]×[0, Cols()].
+// Returns ValidationError if src is nil, dstR < 0, dstC < 0, or src overhangs g.
+func (g *Grid) validateOverlay(dstR, dstC int, src *Grid) error {
+	if err := validateNotNil(src, "src"); err != nil {
+		return err
+	}
+	if err := validateNonNegative(dstR, "dstR"); err != nil {
+		return err
+	}
+	if err := validateNonNegative(dstC, "dstC"); err != nil {
+		return err
+	}
+	if dstR+src.rows > g.rows {
+		return &ValidationError{
+			Field:   "src",
+			Value:   fmt.Sprintf("dstR=%d, src.rows=%d, rows=%d", dstR, src.rows, g.rows),
+			Message: "exceeds rows",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	if dstC+src.cols > g.cols {
+		return &ValidationError{
+			Field:   "src",
+			Value:   fmt.Sprintf("dstC=%d, src.cols=%d, cols=%d", dstC, src.cols, g.cols),
+			Message: "exceeds columns",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	return nil
+}
+
+// shiftDir is the direction of a rectangle shift.
+type shiftDir int
+
+const (
+	shiftRight shiftDir = iota
+	shiftLeft
+	shiftUp
+	shiftDown
+)
+
+// String returns the direction name used in shift error values.
+func (d shiftDir) String() string {
+	switch d {
+	case shiftRight:
+		return "right"
+	case shiftLeft:
+		return "left"
+	case shiftUp:
+		return "up"
+	case shiftDown:
+		return "down"
+	default:
+		panic(fmt.Sprintf("btmp: invalid shift direction %d", int(d)))
+	}
+}
+
+// validateShiftTarget validates that the band of cells a rectangle sweeps
+// into when shifted n steps in direction dir lies within grid bounds and is
+// free (all zeros).
+// Assumes the rectangle itself has already been validated and n > 0.
+// Returns ValidationError if the band is out of bounds or not free; its Value
+// is the direction name, suffixed with " by n" when n > 1.
+func (g *Grid) validateShiftTarget(r, c, h, w, n int, dir shiftDir) error {
+	var br, bc, bh, bw int
+	var unit string
+	switch dir {
+	case shiftRight:
+		br, bc, bh, bw, unit = r, c+w, h, n, "column"
+	case shiftLeft:
+		br, bc, bh, bw, unit = r, c-n, h, n, "column"
+	case shiftUp:
+		br, bc, bh, bw, unit = r-n, c, n, w, "row"
+	case shiftDown:
+		br, bc, bh, bw, unit = r+h, c, n, w, "row"
+	default:
+		panic(fmt.Sprintf("btmp: invalid shift direction %d", int(dir)))
+	}
+
+	value := dir.String()
+	if n > 1 {
+		value = fmt.Sprintf("%s by %d", dir, n)
+	}
+	if br < 0 || bc < 0 || br+bh > g.rows || bc+bw > g.cols {
+		return &ValidationError{
+			Field:   "shift",
+			Value:   value,
+			Message: "target " + unit + " out of bounds",
+			Err:     ErrOutOfBounds,
+		}
+	}
+	if !g.rectZero(br, bc, bh, bw) {
+		return &ValidationError{
+			Field:   "shift",
+			Value:   value,
+			Message: "target " + unit + " not free",
+			Err:     ErrInvalidArgument,
 		}
 	}
 	return nil
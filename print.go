@@ -3,67 +3,114 @@ package btmp
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
+// PrintOptions controls the output of Bitmap.PrintWith and
+// Bitmap.PrintRangeWith. Use DefaultPrintOptions for plain binary output and
+// adjust from there.
+type PrintOptions struct {
+	// Base is the output base: 2 (binary), 8 (octal), or 16 (hexadecimal).
+	Base int
+	// Grouped inserts Sep between groups of GroupSize output units.
+	Grouped bool
+	// GroupSize is the number of units per group: bits for base 2, digits
+	// for base 8 and 16. Must be positive when Grouped is set.
+	GroupSize int
+	// Sep is the separator inserted between groups.
+	Sep string
+	// Lowercase emits base 16 digits a-f instead of A-F. Padding and grouping
+	// are unaffected.
+	Lowercase bool
+	// LSBFirst reverses the bit order within each formatted chunk of up to
+	// 64 bits (63 in base 8), so bit start is emitted first and the output
+	// reads in index order. By default the highest-index bit of each chunk
+	// comes first. Digits in base 8 and 16 are formed after reversal.
+	// Grouping is unaffected.
+	LSBFirst bool
+	// Set and Clear are the glyphs for set and clear bits in base 2.
+	// A zero rune selects the default '1' or '0', so PrintOptions{Base: 2}
+	// prints plain binary. They are ignored for base 8 and 16, which always
+	// print digits.
+	Set, Clear rune
+}
+
+// DefaultPrintOptions returns the options used by Print: ungrouped binary
+// with '1' for set bits and '0' for clear bits.
+func DefaultPrintOptions() PrintOptions {
+	return PrintOptions{
+		Base:  2,
+		Set:   '1',
+		Clear: '0',
+	}
+}
+
 // formatBits formats a bit sequence into a string representation.
 //
 // Parameters:
 //   - bits: source bits, right-aligned (low bits used if bitCount < 64)
 //   - bitCount: number of valid bits to format (1-64)
-//   - base: output base (2 for binary, 16 for hexadecimal)
-//   - grouped: if true, insert separators between groups
-//   - groupSize: units per group - for base 2: bits, for base 16: hex digits
-//   - sep: separator string inserted between groups
+//   - opts: output base, grouping, and base-2 glyphs
 //
 // For base 16:
 //   - Groups 4 bits per hex digit, left-to-right
 //   - Right-pads incomplete final group with zeros
 //   - Example: 6 bits "101100" → "B0" (treated as "10110000")
+//   - Digits are uppercase unless opts.Lowercase is set
+//
+// For base 8:
+//   - Groups 3 bits per octal digit, padded like base 16
+//   - Example: 6 bits 0x2C → "54"
 //
 // For base 2:
-//   - Outputs '0' and '1' characters in index order (left-to-right)
+//   - Outputs opts.Set and opts.Clear glyphs, most significant bit first
 //   - No padding
 //
 // Grouping:
-//   - Inserts sep every groupSize output units
+//   - Inserts opts.Sep every opts.GroupSize output units
 //   - For base 2: groupSize is bit count
-//   - For base 16: groupSize is hex digit count
+//   - For base 8 and 16: groupSize is digit count
 //   - Last group may be shorter than groupSize
 //   - Example base 2: bits=0xFF, bitCount=8, groupSize=4 → "1111_1111"
 //   - Example base 16: bits=0xABCD, bitCount=16, groupSize=2 → "AB CD"
 //
-// Panics if bitCount <= 0, bitCount > 64, base not in {2,16},
+// Panics if bitCount <= 0, bitCount > 64, base not in {2,8,16},
 // or grouped && groupSize <= 0.
-func formatBits(bits uint64, bitCount int, base int, grouped bool, groupSize int, sep string) string {
+func formatBits(bits uint64, bitCount int, opts PrintOptions) string {
 	// Validation
 	if bitCount <= 0 || bitCount > WordBits {
 		panic("bitCount must be > 0 and <= 64")
 	}
-	if base != 2 && base != 16 {
-		panic("base must be 2 or 16")
+	if opts.Base != 2 && opts.Base != 8 && opts.Base != 16 {
+		panic("base must be 2, 8, or 16")
 	}
-	if grouped && groupSize <= 0 {
+	if opts.Grouped && opts.GroupSize <= 0 {
 		panic("groupSize must be positive when grouped")
 	}
 
 	var s string
-	if base == 2 {
-		s = formatBinary(bits, bitCount)
-	} else { // base == 16
-		s = formatHex(bits, bitCount)
+	switch opts.Base {
+	case 2:
+		s = formatBinary(bits, bitCount, opts.Set, opts.Clear)
+	case 8:
+		s = formatOctal(bits, bitCount)
+	default: // base == 16
+		s = formatHex(bits, bitCount, opts.Lowercase)
 	}
 
-	if grouped {
-		s = applyGrouping(s, groupSize, sep)
+	if opts.Grouped {
+		s = applyGrouping(s, opts.GroupSize, opts.Sep)
 	}
 
 	return s
 }
 
-// formatBinary formats bits as binary string with exact bitCount digits.
+// formatBinary formats bits as binary string with exact bitCount digits,
+// drawing set bits as set and clear bits as clear; zero glyphs default to
+// '1' and '0'.
 // Pads left with zeros if needed. Takes rightmost bitCount bits.
 // Internal helper - no validation, no grouping.
-func formatBinary(bits uint64, bitCount int) string {
+func formatBinary(bits uint64, bitCount int, set, clear rune) string {
 	s := fmt.Sprintf("%b", bits)
 
 	// Pad left if needed
@@ -72,38 +119,73 @@ func formatBinary(bits uint64, bitCount int) string {
 	}
 
 	// Take rightmost bitCount characters
-	return s[len(s)-bitCount:]
+	s = s[len(s)-bitCount:]
+	if set == 0 {
+		set = '1'
+	}
+	if clear == 0 {
+		clear = '0'
+	}
+	if set == '1' && clear == '0' {
+		return s
+	}
+	return strings.Map(func(c rune) rune {
+		if c == '1' {
+			return set
+		}
+		return clear
+	}, s)
 }
 
-// formatHex formats bits as hexadecimal string (uppercase).
+// formatHex formats bits as hexadecimal string, uppercase unless lower is set.
 // Right-pads to complete hex digit if bitCount not divisible by 4.
 // Internal helper - no validation, no grouping.
-func formatHex(bits uint64, bitCount int) string {
+func formatHex(bits uint64, bitCount int, lower bool) string {
 	// Calculate number of hex digits needed (ceiling division)
 	hexDigits := (bitCount + 3) / 4
 
 	// Create format string with zero-padding
-	format := fmt.Sprintf("%%0%dX", hexDigits)
+	verb := 'X'
+	if lower {
+		verb = 'x'
+	}
+	format := fmt.Sprintf("%%0%d%c", hexDigits, verb)
+
+	return fmt.Sprintf(format, bits)
+}
+
+// formatOctal formats bits as octal string.
+// Pads to complete octal digit if bitCount not divisible by 3.
+// Internal helper - no validation, no grouping.
+func formatOctal(bits uint64, bitCount int) string {
+	// Calculate number of octal digits needed (ceiling division)
+	octDigits := (bitCount + 2) / 3
+
+	// Create format string with zero-padding
+	format := fmt.Sprintf("%%0%do", octDigits)
 
 	return fmt.Sprintf(format, bits)
 }
 
-// applyGrouping inserts separators every groupSize characters from left to right.
+// applyGrouping inserts separators every groupSize runes from left to right.
 // Last group may be shorter than groupSize.
 // Internal helper - no validation.
 func applyGrouping(s string, groupSize int, sep string) string {
-	if groupSize <= 0 || groupSize >= len(s) {
+	n := utf8.RuneCountInString(s)
+	if groupSize <= 0 || groupSize >= n {
 		return s
 	}
 
 	var builder strings.Builder
-	builder.Grow(len(s) + (len(s)/groupSize)*len(sep))
+	builder.Grow(len(s) + (n/groupSize)*len(sep))
 
-	for i, c := range s {
+	i := 0
+	for _, c := range s {
 		if i > 0 && i%groupSize == 0 {
 			builder.WriteString(sep)
 		}
 		builder.WriteRune(c)
+		i++
 	}
 
 	return builder.String()
@@ -1,6 +1,23 @@
 package btmp
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Sentinel errors classifying a ValidationError. Match them with errors.Is,
+// e.g. errors.Is(err, ErrOutOfBounds).
+var (
+	ErrOutOfBounds     = errors.New("btmp: out of bounds")
+	ErrNegative        = errors.New("btmp: negative value")
+	ErrOverflow        = errors.New("btmp: overflow")
+	ErrLengthMismatch  = errors.New("btmp: length mismatch")
+	ErrNilArgument     = errors.New("btmp: nil argument")
+	ErrInvalidArgument = errors.New("btmp: invalid argument")
+	ErrInvalidData     = errors.New("btmp: invalid encoded data")
+)
 
 // ValidationError represents a validation failure with context about what failed.
 type ValidationError struct {
@@ -8,6 +25,7 @@ type ValidationError struct {
 	Value   any    // The actual value that failed (for debugging)
 	Message string // Description of the validation failure
 	Context string // Optional context (e.g., "Grid.SetRect", "Bitmap.CopyRange")
+	Err     error  // Sentinel classifying the failure (e.g., ErrOutOfBounds)
 }
 
 // Error implements the error interface.
@@ -18,6 +36,13 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("%s: %s (got %v)", e.Field, e.Message, e.Value)
 }
 
+// Is reports whether target is the sentinel classifying e, so that
+// errors.Is(err, ErrOutOfBounds) matches bounds failures. The Error() text
+// does not depend on the sentinel.
+func (e *ValidationError) Is(target error) bool {
+	return e.Err != nil && e.Err == target
+}
+
 // WithContext adds context to the validation error.
 func (e *ValidationError) WithContext(ctx string) *ValidationError {
 	e.Context = ctx
@@ -32,6 +57,7 @@ func validateNonNegative(value int, name string) error {
 			Field:   name,
 			Value:   value,
 			Message: "must be non-negative",
+			Err:     ErrNegative,
 		}
 	}
 	return nil
@@ -41,23 +67,30 @@ func validateNonNegative(value int, name string) error {
 // Returns ValidationError if value <= 0.
 func validatePositive(value int, name string) error {
 	if value <= 0 {
+		err := ErrInvalidArgument
+		if value < 0 {
+			err = ErrNegative
+		}
 		return &ValidationError{
 			Field:   name,
 			Value:   value,
 			Message: "must be positive",
+			Err:     err,
 		}
 	}
 	return nil
 }
 
 // validateNotNil validates that pointer is not nil.
-// Returns ValidationError if ptr is nil.
+// Returns ValidationError if ptr is nil, including a typed nil pointer such
+// as (*Bitmap)(nil), which does not compare equal to nil once boxed in any.
 func validateNotNil(ptr any, name string) error {
-	if ptr == nil {
+	if v := reflect.ValueOf(ptr); ptr == nil || (v.Kind() == reflect.Pointer && v.IsNil()) {
 		return &ValidationError{
 			Field:   name,
 			Value:   nil,
 			Message: "must not be nil",
+			Err:     ErrNilArgument,
 		}
 	}
 	return nil
@@ -71,20 +104,62 @@ func validateRangeOverflow(start, count int) error {
 			Field:   "range",
 			Value:   fmt.Sprintf("start=%d, count=%d", start, count),
 			Message: "overflow",
+			Err:     ErrOverflow,
 		}
 	}
 	return nil
 }
 
 // validateGridSizeMax validates that rows * cols doesn't overflow.
-// Returns ValidationError if rows * cols < 0.
+// Assumes rows and cols are non-negative. Uses a division check so a product
+// that wraps to a small or zero value is still rejected.
+// Returns ValidationError if rows * cols > math.MaxInt.
 func validateGridSizeMax(rows, cols int) error {
-	size := rows * cols
-	if size < 0 {
+	if cols != 0 && rows > math.MaxInt/cols {
 		return &ValidationError{
 			Field:   "size",
 			Value:   fmt.Sprintf("rows=%d, cols=%d", rows, cols),
 			Message: "overflow",
+			Err:     ErrOverflow,
+		}
+	}
+	return nil
+}
+
+// validateRectangular validates that every row of m has the same length.
+// Returns ValidationError if any len(m[r]) differs from len(m[0]).
+func validateRectangular(m [][]bool) error {
+	for r := 1; r < len(m); r++ {
+		if len(m[r]) != len(m[0]) {
+			return &ValidationError{
+				Field:   "m",
+				Value:   fmt.Sprintf("row %d len=%d, row 0 len=%d", r, len(m[r]), len(m[0])),
+				Message: "ragged matrix",
+				Err:     ErrInvalidArgument,
+			}
+		}
+	}
+	return nil
+}
+
+// validatePrintOptions validates the base and grouping of opts.
+// Returns ValidationError if opts.Base not in {2,8,16} or
+// opts.Grouped && opts.GroupSize <= 0.
+func validatePrintOptions(opts PrintOptions) error {
+	if opts.Base != 2 && opts.Base != 8 && opts.Base != 16 {
+		return &ValidationError{
+			Field:   "base",
+			Value:   opts.Base,
+			Message: "must be 2, 8, or 16",
+			Err:     ErrInvalidArgument,
+		}
+	}
+	if opts.Grouped && opts.GroupSize <= 0 {
+		return &ValidationError{
+			Field:   "groupSize",
+			Value:   opts.GroupSize,
+			Message: "must be positive when grouped",
+			Err:     ErrInvalidArgument,
 		}
 	}
 	return nil
@@ -98,6 +173,7 @@ func validateWordBits(n int) error {
 			Field:   "n",
 			Value:   n,
 			Message: fmt.Sprintf("must be > 0 and <= %d", WordBits),
+			Err:     ErrInvalidArgument,
 		}
 	}
 	return nil
@@ -111,6 +187,37 @@ func validateSameLength(a, b *Bitmap) error {
 			Field:   "length",
 			Value:   fmt.Sprintf("a=%d, b=%d", a.Len(), b.Len()),
 			Message: "bitmaps must have same length",
+			Err:     ErrLengthMismatch,
+		}
+	}
+	return nil
+}
+
+// validateWordsLength validates that words can hold n bits.
+// Returns ValidationError if len(words) < ceil(n/64).
+func validateWordsLength(words []uint64, n uint) error {
+	need := (n + IndexMask) >> WordShift
+	if uint(len(words)) < need {
+		return &ValidationError{
+			Field:   "words",
+			Value:   fmt.Sprintf("words=%d, need=%d", len(words), need),
+			Message: "too short for n bits",
+			Err:     ErrLengthMismatch,
+		}
+	}
+	return nil
+}
+
+// validateBytesLength validates that data can hold n bits.
+// Returns ValidationError if len(data) < ceil(n/8).
+func validateBytesLength(data []byte, n uint) error {
+	need := (n + 7) / 8
+	if uint(len(data)) < need {
+		return &ValidationError{
+			Field:   "data",
+			Value:   fmt.Sprintf("bytes=%d, need=%d", len(data), need),
+			Message: "too short for n bits",
+			Err:     ErrLengthMismatch,
 		}
 	}
 	return nil
@@ -0,0 +1,68 @@
+package btmp_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/neox5/btmp"
+)
+
+// recoverError runs f and returns the error it panics with, or nil.
+func recoverError(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	f()
+	return nil
+}
+
+// TestValidationErrorIs validates sentinel matching with errors.Is.
+func TestValidationErrorIs(t *testing.T) {
+	b := btmp.New(10)
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"out of bounds", recoverError(func() { b.SetBit(10) }), btmp.ErrOutOfBounds},
+		{"negative", recoverError(func() { b.SetBit(-1) }), btmp.ErrNegative},
+		{"overflow", recoverError(func() { btmp.NewGridWithSize(math.MaxInt, 2) }), btmp.ErrOverflow},
+		{"length mismatch", recoverError(func() { b.And(btmp.New(11)) }), btmp.ErrLengthMismatch},
+		{"nil argument", recoverError(func() { b.And(nil) }), btmp.ErrNilArgument},
+		{"invalid argument", recoverError(func() { b.PrintFormat(3, false, 0, "") }), btmp.ErrInvalidArgument},
+		{"invalid data", btmp.New(0).UnmarshalBinary([]byte{1, 2}), btmp.ErrInvalidData},
+		{"grid bounds", recoverError(func() { btmp.NewGridWithSize(2, 2).SetRect(1, 1, 2, 1) }), btmp.ErrOutOfBounds},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.want) {
+				t.Fatalf("expected errors.Is(%v, %v)", tt.err, tt.want)
+			}
+			var ve *btmp.ValidationError
+			if !errors.As(tt.err, &ve) {
+				t.Errorf("expected *ValidationError, got %T", tt.err)
+			}
+			if errors.Is(tt.err, btmp.ErrOverflow) && tt.want != btmp.ErrOverflow {
+				t.Errorf("expected no match for unrelated sentinel")
+			}
+		})
+	}
+
+	t.Run("message unchanged", func(t *testing.T) {
+		err := recoverError(func() { b.SetBit(-1) })
+		want := "Bitmap.SetBit: pos: must be non-negative (got -1)"
+		if err == nil || err.Error() != want {
+			t.Errorf("expected %q, got %v", want, err)
+		}
+	})
+
+	t.Run("typed nil pointer", func(t *testing.T) {
+		var other *btmp.Bitmap
+		if err := recoverError(func() { b.Or(other) }); !errors.Is(err, btmp.ErrNilArgument) {
+			t.Errorf("expected ErrNilArgument, got %v", err)
+		}
+	})
+}